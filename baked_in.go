@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -11,10 +12,13 @@ import (
 	"net/url"
 	"os"
 	"reflect"
+	"regexp"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"golang.org/x/crypto/sha3"
@@ -42,6 +46,27 @@ func wrapFunc(fn Func) FuncCtx {
 }
 
 var (
+	// pipeParamTags are the baked in validations whose param is a list separated by '|', so isn't split into
+	// validations or'd together; they therefore can't be or'd with others.
+	pipeParamTags = map[string]struct{}{
+		"datetime_any": {},
+	}
+
+	// requiredTags are the required validations run against non-pointer struct fields, which are otherwise only
+	// descended into, when enabled using WithRequiredStructEnabled.
+	requiredTags = map[string]struct{}{
+		requiredTag:           {},
+		requiredIfTag:         {},
+		requiredIfAnyTag:      {},
+		requiredUnlessTag:     {},
+		requiredWithTag:       {},
+		requiredWithAllTag:    {},
+		requiredWithoutTag:    {},
+		requiredWithoutAllTag: {},
+		requiredOneOfTag:      {},
+		requiredExactlyOneTag: {},
+	}
+
 	restrictedTags = map[string]struct{}{
 		diveTag:           {},
 		keysTag:           {},
@@ -54,6 +79,10 @@ var (
 		noStructLevelTag:  {},
 		requiredTag:       {},
 		isdefault:         {},
+		customTypeTag:     {},
+		badCrossFieldTag:  {},
+		truncatedTag:      {},
+		maxDepthTag:       {},
 	}
 
 	// BakedInAliasValidators is a default mapping of a single validation tag that
@@ -70,16 +99,22 @@ var (
 	bakedInValidators = map[string]Func{
 		"required":                      hasValue,
 		"required_if":                   requiredIf,
+		"required_if_any":               requiredIfAny,
 		"required_unless":               requiredUnless,
 		"required_with":                 requiredWith,
 		"required_with_all":             requiredWithAll,
 		"required_without":              requiredWithout,
 		"required_without_all":          requiredWithoutAll,
+		"required_one_of":               requiredOneOf,
+		"required_exactly_one_of":       requiredExactlyOneOf,
 		"excluded_with":                 excludedWith,
 		"excluded_with_all":             excludedWithAll,
 		"excluded_without":              excludedWithout,
 		"excluded_without_all":          excludedWithoutAll,
+		"skip_if":                       skipIf,
+		"skip_unless":                   skipUnless,
 		"isdefault":                     isDefault,
+		"notblank":                      notBlank,
 		"len":                           hasLengthOf,
 		"min":                           hasMinOf,
 		"max":                           hasMaxOf,
@@ -90,6 +125,11 @@ var (
 		"gt":                            isGt,
 		"gte":                           isGte,
 		"eqfield":                       isEqField,
+		"lenfield":                      isLenField,
+		"ltlenfield":                    isLtLenField,
+		"ltelenfield":                   isLteLenField,
+		"gtlenfield":                    isGtLenField,
+		"gtelenfield":                   isGteLenField,
 		"eqcsfield":                     isEqCrossStructField,
 		"necsfield":                     isNeCrossStructField,
 		"gtcsfield":                     isGtCrossStructField,
@@ -103,12 +143,22 @@ var (
 		"ltfield":                       isLtField,
 		"fieldcontains":                 fieldContains,
 		"fieldexcludes":                 fieldExcludes,
+		"containsfield":                 containsField,
+		"coerce":                        isCoerced,
 		"alpha":                         isAlpha,
 		"alphanum":                      isAlphanum,
 		"alphaunicode":                  isAlphaUnicode,
 		"alphanumunicode":               isAlphanumUnicode,
+		"alphaspace":                    isAlphaSpace,
+		"alphanumspace":                 isAlphanumSpace,
+		"alphaspaceunicode":             isAlphaSpaceUnicode,
+		"alphanumspaceunicode":          isAlphanumSpaceUnicode,
 		"numeric":                       isNumeric,
 		"number":                        isNumber,
+		"integer":                       isInteger,
+		"decimal":                       isDecimal,
+		"digits":                        hasDigits,
+		"digits_between":                hasDigitsBetween,
 		"hexadecimal":                   isHexadecimal,
 		"hexcolor":                      isHEXColor,
 		"rgb":                           isRGB,
@@ -121,6 +171,9 @@ var (
 		"uri":                           isURI,
 		"urn_rfc2141":                   isUrnRFC2141, // RFC 2141
 		"file":                          isFile,
+		"filepath":                      isFilePath,
+		"password":                      isPassword,
+		"uri_path":                      isURIPath,
 		"base64":                        isBase64,
 		"base64url":                     isBase64URL,
 		"contains":                      contains,
@@ -137,6 +190,7 @@ var (
 		"isbn10":                        isISBN10,
 		"isbn13":                        isISBN13,
 		"eth_addr":                      isEthereumAddress,
+		"eth_addr_checksum":             isEthereumAddressChecksum,
 		"btc_addr":                      isBitcoinAddress,
 		"btc_addr_bech32":               isBitcoinBech32Address,
 		"uuid":                          isUUID,
@@ -151,6 +205,7 @@ var (
 		"printascii":                    isPrintableASCII,
 		"multibyte":                     hasMultiByteCharacter,
 		"datauri":                       isDataURI,
+		"datauri_image":                 isImageDataURI,
 		"latitude":                      isLatitude,
 		"longitude":                     isLongitude,
 		"ssn":                           isSSN,
@@ -174,8 +229,19 @@ var (
 		"hostname":                      isHostnameRFC952,  // RFC 952
 		"hostname_rfc1123":              isHostnameRFC1123, // RFC 1123
 		"fqdn":                          isFQDN,
+		"fqdn_relaxed":                  isFQDNRelaxed,
 		"unique":                        isUnique,
+		"method":                        isMethodValid,
+		"future":                        isFuture,
+		"past":                          isPast,
+		"agemin":                        hasMinAge,
+		"agemax":                        hasMaxAge,
+		"gtnow":                         isFuture,
+		"ltnow":                         isPast,
 		"oneof":                         isOneOf,
+		"oneofci":                       isOneOfCI,
+		"inset":                         isInSet,
+		"rune_in":                       isRuneIn,
 		"html":                          isHTML,
 		"html_encoded":                  isHTMLEncoded,
 		"url_encoded":                   isURLEncoded,
@@ -185,7 +251,13 @@ var (
 		"lowercase":                     isLowercase,
 		"uppercase":                     isUppercase,
 		"datetime":                      isDatetime,
+		"datetime_any":                  isDatetimeAny,
+		"rfc3339":                       isDatetimeLayout(time.RFC3339),
+		"rfc3339_nano":                  isDatetimeLayout(time.RFC3339Nano),
+		"date":                          isDatetimeLayout("2006-01-02"),
+		"time":                          isDatetimeLayout("15:04:05"),
 		"timezone":                      isTimeZone,
+		"regexp":                        isRegexp,
 		"iso3166_1_alpha2":              isIso3166Alpha2,
 		"iso3166_1_alpha3":              isIso3166Alpha3,
 		"iso3166_1_alpha_numeric":       isIso3166AlphaNumeric,
@@ -199,6 +271,13 @@ var (
 var oneofValsCache = map[string][]string{}
 var oneofValsCacheRWLock = sync.RWMutex{}
 
+var timeZoneCache = map[string]struct{}{}
+var timeZoneCacheRWLock = sync.RWMutex{}
+
+// regexpCache holds patterns which compiled successfully, bounded so that validating arbitrary
+// input can't grow it unbounded.
+var regexpCache = newPatternCache(1024)
+
 func parseOneOfParam2(s string) []string {
 	oneofValsCacheRWLock.RLock()
 	vals, ok := oneofValsCache[s]
@@ -216,21 +295,21 @@ func parseOneOfParam2(s string) []string {
 }
 
 func isURLEncoded(fl FieldLevel) bool {
-	return uRLEncodedRegex.MatchString(fl.Field().String())
+	return uRLEncodedRegex.MatchString(fieldString(fl))
 }
 
 func isHTMLEncoded(fl FieldLevel) bool {
-	return hTMLEncodedRegex.MatchString(fl.Field().String())
+	return hTMLEncodedRegex.MatchString(fieldString(fl))
 }
 
 func isHTML(fl FieldLevel) bool {
-	return hTMLRegex.MatchString(fl.Field().String())
+	return hTMLRegex.MatchString(fieldString(fl))
 }
 
 func isOneOf(fl FieldLevel) bool {
 	vals := parseOneOfParam2(fl.Param())
 
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	var v string
 	switch field.Kind() {
@@ -251,6 +330,163 @@ func isOneOf(fl FieldLevel) bool {
 	return false
 }
 
+// isOneOfCI is the validation function for validating if the current field's value is one of the provided
+// string values, ignoring case as defined by strings.EqualFold.
+func isOneOfCI(fl FieldLevel) bool {
+	vals := parseOneOfParam2(fl.Param())
+
+	field := bytesAsString(fl.Field())
+
+	if field.Kind() != reflect.String {
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+
+	v := field.String()
+
+	for i := 0; i < len(vals); i++ {
+		if strings.EqualFold(vals[i], v) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDigits is the validation function for validating if the current field's integer, or numeric string, value
+// has exactly the number of decimal digits specified by the param's value, ignoring any sign.
+func hasDigits(fl FieldLevel) bool {
+	n, ok := countDigits(bytesAsString(fl.Field()))
+	return ok && n == asInt(fl.Param())
+}
+
+// hasDigitsBetween is the validation function for validating if the current field's integer, or numeric string,
+// value has a number of decimal digits, ignoring any sign, within the inclusive range given by the param's two
+// values eg. digits_between=3 5.
+func hasDigitsBetween(fl FieldLevel) bool {
+	params := parseOneOfParam2(fl.Param())
+	if len(params) != 2 {
+		panic(fmt.Sprintf("Bad param number for digits_between %s", fl.FieldName()))
+	}
+
+	n, ok := countDigits(bytesAsString(fl.Field()))
+	return ok && n >= asInt(params[0]) && n <= asInt(params[1])
+}
+
+// countDigits returns the number of decimal digits of the integer, or numeric string, value ignoring any sign
+// and false if a string holds anything other than digits. Leading zeros of strings are counted.
+func countDigits(field reflect.Value) (int64, bool) {
+	switch field.Kind() {
+
+	case reflect.String:
+		s := field.String()
+		if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+			s = s[1:]
+		}
+		if len(s) == 0 {
+			return 0, false
+		}
+		for i := 0; i < len(s); i++ {
+			if s[i] < '0' || s[i] > '9' {
+				return 0, false
+			}
+		}
+		return int64(len(s)), true
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s := strconv.FormatInt(field.Int(), 10)
+		return int64(len(strings.TrimPrefix(s, "-"))), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int64(len(strconv.FormatUint(field.Uint(), 10))), true
+	}
+
+	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+}
+
+// isRuneIn is the validation function for validating that the field, a single rune string or a rune or byte,
+// falls within the ranges, eg. A-Z, or is one of the characters, given by the param eg. rune_in=A-Z0-9_.
+func isRuneIn(fl FieldLevel) bool {
+	field := bytesAsString(fl.Field())
+
+	var r rune
+
+	switch field.Kind() {
+	case reflect.String:
+		s := field.String()
+		if utf8.RuneCountInString(s) != 1 {
+			return false
+		}
+		r, _ = utf8.DecodeRuneInString(s)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		r = rune(field.Int())
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		r = rune(field.Uint())
+
+	default:
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+
+	for _, rng := range parseRuneRanges(fl.Param()) {
+		if r >= rng[0] && r <= rng[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseRuneRanges parses the rune_in param into inclusive ranges; a '-' between two characters denoting a
+// range and otherwise being the character itself.
+func parseRuneRanges(param string) [][2]rune {
+	runes := []rune(param)
+	if len(runes) == 0 {
+		panic("rune_in requires at least one character or range")
+	}
+
+	ranges := make([][2]rune, 0, len(runes))
+
+	for i := 0; i < len(runes); i++ {
+		if i+2 < len(runes) && runes[i+1] == '-' {
+			if runes[i] > runes[i+2] {
+				panic(fmt.Sprintf("Bad rune_in range '%c-%c'", runes[i], runes[i+2]))
+			}
+			ranges = append(ranges, [2]rune{runes[i], runes[i+2]})
+			i += 2
+			continue
+		}
+		ranges = append(ranges, [2]rune{runes[i], runes[i]})
+	}
+
+	return ranges
+}
+
+// isInSet is the validation function for validating if the current field's value is a member of the set, registered
+// using RegisterDynamicSet, named by the param's value as of the time of validation.
+func isInSet(fl FieldLevel) bool {
+	provider, ok := fl.(*validate).v.dynamicSets[fl.Param()]
+	if !ok {
+		panic(fmt.Sprintf("Undefined dynamic set '%s' on field '%s'", fl.Param(), fl.FieldName()))
+	}
+
+	field := bytesAsString(fl.Field())
+
+	var v string
+	switch field.Kind() {
+	case reflect.String:
+		v = field.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v = strconv.FormatInt(field.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v = strconv.FormatUint(field.Uint(), 10)
+	default:
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+
+	_, ok = provider()[v]
+	return ok
+}
+
 // isUnique is the validation function for validating if each array|slice|map value is unique
 func isUnique(fl FieldLevel) bool {
 
@@ -304,7 +540,7 @@ func isUnique(fl FieldLevel) bool {
 // IsMAC is the validation function for validating if the field's value is a valid MAC address.
 func isMAC(fl FieldLevel) bool {
 
-	_, err := net.ParseMAC(fl.Field().String())
+	_, err := net.ParseMAC(fieldString(fl))
 
 	return err == nil
 }
@@ -312,7 +548,7 @@ func isMAC(fl FieldLevel) bool {
 // IsCIDRv4 is the validation function for validating if the field's value is a valid v4 CIDR address.
 func isCIDRv4(fl FieldLevel) bool {
 
-	ip, _, err := net.ParseCIDR(fl.Field().String())
+	ip, _, err := net.ParseCIDR(fieldString(fl))
 
 	return err == nil && ip.To4() != nil
 }
@@ -320,7 +556,7 @@ func isCIDRv4(fl FieldLevel) bool {
 // IsCIDRv6 is the validation function for validating if the field's value is a valid v6 CIDR address.
 func isCIDRv6(fl FieldLevel) bool {
 
-	ip, _, err := net.ParseCIDR(fl.Field().String())
+	ip, _, err := net.ParseCIDR(fieldString(fl))
 
 	return err == nil && ip.To4() == nil
 }
@@ -328,7 +564,7 @@ func isCIDRv6(fl FieldLevel) bool {
 // IsCIDR is the validation function for validating if the field's value is a valid v4 or v6 CIDR address.
 func isCIDR(fl FieldLevel) bool {
 
-	_, _, err := net.ParseCIDR(fl.Field().String())
+	_, _, err := net.ParseCIDR(fieldString(fl))
 
 	return err == nil
 }
@@ -336,7 +572,7 @@ func isCIDR(fl FieldLevel) bool {
 // IsIPv4 is the validation function for validating if a value is a valid v4 IP address.
 func isIPv4(fl FieldLevel) bool {
 
-	ip := net.ParseIP(fl.Field().String())
+	ip := net.ParseIP(fieldString(fl))
 
 	return ip != nil && ip.To4() != nil
 }
@@ -344,7 +580,7 @@ func isIPv4(fl FieldLevel) bool {
 // IsIPv6 is the validation function for validating if the field's value is a valid v6 IP address.
 func isIPv6(fl FieldLevel) bool {
 
-	ip := net.ParseIP(fl.Field().String())
+	ip := net.ParseIP(fieldString(fl))
 
 	return ip != nil && ip.To4() == nil
 }
@@ -352,7 +588,7 @@ func isIPv6(fl FieldLevel) bool {
 // IsIP is the validation function for validating if the field's value is a valid v4 or v6 IP address.
 func isIP(fl FieldLevel) bool {
 
-	ip := net.ParseIP(fl.Field().String())
+	ip := net.ParseIP(fieldString(fl))
 
 	return ip != nil
 }
@@ -360,7 +596,7 @@ func isIP(fl FieldLevel) bool {
 // IsSSN is the validation function for validating if the field's value is a valid SSN.
 func isSSN(fl FieldLevel) bool {
 
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	if field.Len() != 11 {
 		return false
@@ -371,7 +607,7 @@ func isSSN(fl FieldLevel) bool {
 
 // IsLongitude is the validation function for validating if the field's value is a valid longitude coordinate.
 func isLongitude(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	var v string
 	switch field.Kind() {
@@ -394,7 +630,7 @@ func isLongitude(fl FieldLevel) bool {
 
 // IsLatitude is the validation function for validating if the field's value is a valid latitude coordinate.
 func isLatitude(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	var v string
 	switch field.Kind() {
@@ -418,7 +654,7 @@ func isLatitude(fl FieldLevel) bool {
 // IsDataURI is the validation function for validating if the field's value is a valid data URI.
 func isDataURI(fl FieldLevel) bool {
 
-	uri := strings.SplitN(fl.Field().String(), ",", 2)
+	uri := strings.SplitN(fieldString(fl), ",", 2)
 
 	if len(uri) != 2 {
 		return false
@@ -431,10 +667,61 @@ func isDataURI(fl FieldLevel) bool {
 	return base64Regex.MatchString(uri[1])
 }
 
+// isImageDataURI is the validation function for validating if the field's value is a valid data URI,
+// strictly following the RFC 2397 grammar, whose mediatype is an image.
+func isImageDataURI(fl FieldLevel) bool {
+	mediaType, ok := parseDataURI(fieldString(fl))
+	return ok && strings.HasPrefix(strings.ToLower(mediaType), "image/")
+}
+
+// parseDataURI parses a data URI of the form data:[<mediatype>][;base64],<data> as defined by RFC 2397,
+// returning its type/subtype, if any, and whether the URI is valid. Parameters of the mediatype must be
+// attribute=value pairs and the data must decode, either as base64 or as URL escaped octets.
+func parseDataURI(uri string) (mediaType string, ok bool) {
+	if !strings.HasPrefix(uri, "data:") {
+		return "", false
+	}
+
+	idx := strings.IndexByte(uri, ',')
+	if idx == -1 {
+		return "", false
+	}
+
+	params := strings.Split(uri[len("data:"):idx], ";")
+	data := uri[idx+1:]
+
+	isBase64 := len(params) > 1 && params[len(params)-1] == "base64"
+	if isBase64 {
+		params = params[:len(params)-1]
+	}
+
+	if len(params[0]) > 0 {
+		if !dataURIMediaTypeRegex.MatchString(params[0]) {
+			return "", false
+		}
+		mediaType = params[0]
+	}
+
+	for _, param := range params[1:] {
+		if !dataURIParamRegex.MatchString(param) {
+			return "", false
+		}
+	}
+
+	var err error
+	if isBase64 {
+		_, err = base64.StdEncoding.DecodeString(data)
+	} else {
+		_, err = url.PathUnescape(data)
+	}
+
+	return mediaType, err == nil
+}
+
 // HasMultiByteCharacter is the validation function for validating if the field's value has a multi byte character.
 func hasMultiByteCharacter(fl FieldLevel) bool {
 
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	if field.Len() == 0 {
 		return true
@@ -445,52 +732,52 @@ func hasMultiByteCharacter(fl FieldLevel) bool {
 
 // IsPrintableASCII is the validation function for validating if the field's value is a valid printable ASCII character.
 func isPrintableASCII(fl FieldLevel) bool {
-	return printableASCIIRegex.MatchString(fl.Field().String())
+	return printableASCIIRegex.MatchString(fieldString(fl))
 }
 
 // IsASCII is the validation function for validating if the field's value is a valid ASCII character.
 func isASCII(fl FieldLevel) bool {
-	return aSCIIRegex.MatchString(fl.Field().String())
+	return aSCIIRegex.MatchString(fieldString(fl))
 }
 
 // IsUUID5 is the validation function for validating if the field's value is a valid v5 UUID.
 func isUUID5(fl FieldLevel) bool {
-	return uUID5Regex.MatchString(fl.Field().String())
+	return uUID5Regex.MatchString(fieldString(fl))
 }
 
 // IsUUID4 is the validation function for validating if the field's value is a valid v4 UUID.
 func isUUID4(fl FieldLevel) bool {
-	return uUID4Regex.MatchString(fl.Field().String())
+	return uUID4Regex.MatchString(fieldString(fl))
 }
 
 // IsUUID3 is the validation function for validating if the field's value is a valid v3 UUID.
 func isUUID3(fl FieldLevel) bool {
-	return uUID3Regex.MatchString(fl.Field().String())
+	return uUID3Regex.MatchString(fieldString(fl))
 }
 
 // IsUUID is the validation function for validating if the field's value is a valid UUID of any version.
 func isUUID(fl FieldLevel) bool {
-	return uUIDRegex.MatchString(fl.Field().String())
+	return uUIDRegex.MatchString(fieldString(fl))
 }
 
 // IsUUID5RFC4122 is the validation function for validating if the field's value is a valid RFC4122 v5 UUID.
 func isUUID5RFC4122(fl FieldLevel) bool {
-	return uUID5RFC4122Regex.MatchString(fl.Field().String())
+	return uUID5RFC4122Regex.MatchString(fieldString(fl))
 }
 
 // IsUUID4RFC4122 is the validation function for validating if the field's value is a valid RFC4122 v4 UUID.
 func isUUID4RFC4122(fl FieldLevel) bool {
-	return uUID4RFC4122Regex.MatchString(fl.Field().String())
+	return uUID4RFC4122Regex.MatchString(fieldString(fl))
 }
 
 // IsUUID3RFC4122 is the validation function for validating if the field's value is a valid RFC4122 v3 UUID.
 func isUUID3RFC4122(fl FieldLevel) bool {
-	return uUID3RFC4122Regex.MatchString(fl.Field().String())
+	return uUID3RFC4122Regex.MatchString(fieldString(fl))
 }
 
 // IsUUIDRFC4122 is the validation function for validating if the field's value is a valid RFC4122 UUID of any version.
 func isUUIDRFC4122(fl FieldLevel) bool {
-	return uUIDRFC4122Regex.MatchString(fl.Field().String())
+	return uUIDRFC4122Regex.MatchString(fieldString(fl))
 }
 
 // IsISBN is the validation function for validating if the field's value is a valid v10 or v13 ISBN.
@@ -501,7 +788,7 @@ func isISBN(fl FieldLevel) bool {
 // IsISBN13 is the validation function for validating if the field's value is a valid v13 ISBN.
 func isISBN13(fl FieldLevel) bool {
 
-	s := strings.Replace(strings.Replace(fl.Field().String(), "-", "", 4), " ", "", 4)
+	s := stripISBNSeparators(fieldString(fl))
 
 	if !iSBN13Regex.MatchString(s) {
 		return false
@@ -522,7 +809,7 @@ func isISBN13(fl FieldLevel) bool {
 // IsISBN10 is the validation function for validating if the field's value is a valid v10 ISBN.
 func isISBN10(fl FieldLevel) bool {
 
-	s := strings.Replace(strings.Replace(fl.Field().String(), "-", "", 3), " ", "", 3)
+	s := strings.ToUpper(stripISBNSeparators(fieldString(fl)))
 
 	if !iSBN10Regex.MatchString(s) {
 		return false
@@ -544,9 +831,14 @@ func isISBN10(fl FieldLevel) bool {
 	return checksum%11 == 0
 }
 
+// stripISBNSeparators removes the hyphens and spaces separating the parts of an ISBN.
+func stripISBNSeparators(s string) string {
+	return strings.Replace(strings.Replace(s, "-", "", -1), " ", "", -1)
+}
+
 // IsEthereumAddress is the validation function for validating if the field's value is a valid Ethereum address.
 func isEthereumAddress(fl FieldLevel) bool {
-	address := fl.Field().String()
+	address := fieldString(fl)
 
 	if !ethAddressRegex.MatchString(address) {
 		return false
@@ -556,6 +848,24 @@ func isEthereumAddress(fl FieldLevel) bool {
 		return true
 	}
 
+	return hasValidEthereumChecksum(address)
+}
+
+// isEthereumAddressChecksum is the validation function for validating if the field's value is a valid
+// ethereum address whose mixed case matches its EIP-55 checksum.
+func isEthereumAddressChecksum(fl FieldLevel) bool {
+	address := fieldString(fl)
+
+	if !ethAddressRegex.MatchString(address) {
+		return false
+	}
+
+	return hasValidEthereumChecksum(address)
+}
+
+// hasValidEthereumChecksum reports whether the case of each letter in the "0x" prefixed address
+// matches its checksum.
+func hasValidEthereumChecksum(address string) bool {
 	// Checksum validation. Reference: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-55.md
 	address = address[2:] // Skip "0x" prefix.
 	h := sha3.NewLegacyKeccak256()
@@ -577,7 +887,7 @@ func isEthereumAddress(fl FieldLevel) bool {
 
 // IsBitcoinAddress is the validation function for validating if the field's value is a valid btc address
 func isBitcoinAddress(fl FieldLevel) bool {
-	address := fl.Field().String()
+	address := fieldString(fl)
 
 	if !btcAddressRegex.MatchString(address) {
 		return false
@@ -614,7 +924,7 @@ func isBitcoinAddress(fl FieldLevel) bool {
 
 // IsBitcoinBech32Address is the validation function for validating if the field's value is a valid bech32 btc address
 func isBitcoinBech32Address(fl FieldLevel) bool {
-	address := fl.Field().String()
+	address := fieldString(fl)
 
 	if !btcLowerAddressRegexBech32.MatchString(address) && !btcUpperAddressRegexBech32.MatchString(address) {
 		return false
@@ -712,27 +1022,27 @@ func containsRune(fl FieldLevel) bool {
 
 	r, _ := utf8.DecodeRuneInString(fl.Param())
 
-	return strings.ContainsRune(fl.Field().String(), r)
+	return strings.ContainsRune(fieldString(fl), r)
 }
 
 // ContainsAny is the validation function for validating that the field's value contains any of the characters specified within the param.
 func containsAny(fl FieldLevel) bool {
-	return strings.ContainsAny(fl.Field().String(), fl.Param())
+	return strings.ContainsAny(fieldString(fl), fl.Param())
 }
 
 // Contains is the validation function for validating that the field's value contains the text specified within the param.
 func contains(fl FieldLevel) bool {
-	return strings.Contains(fl.Field().String(), fl.Param())
+	return strings.Contains(fieldString(fl), fl.Param())
 }
 
 // StartsWith is the validation function for validating that the field's value starts with the text specified within the param.
 func startsWith(fl FieldLevel) bool {
-	return strings.HasPrefix(fl.Field().String(), fl.Param())
+	return strings.HasPrefix(fieldString(fl), fl.Param())
 }
 
 // EndsWith is the validation function for validating that the field's value ends with the text specified within the param.
 func endsWith(fl FieldLevel) bool {
-	return strings.HasSuffix(fl.Field().String(), fl.Param())
+	return strings.HasSuffix(fieldString(fl), fl.Param())
 }
 
 // StartsNotWith is the validation function for validating that the field's value does not start with the text specified within the param.
@@ -747,7 +1057,7 @@ func endsNotWith(fl FieldLevel) bool {
 
 // FieldContains is the validation function for validating if the current field's value contains the field specified by the param's value.
 func fieldContains(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	currentField, _, ok := fl.GetStructFieldOK()
 
@@ -758,9 +1068,44 @@ func fieldContains(fl FieldLevel) bool {
 	return strings.Contains(field.String(), currentField.String())
 }
 
+// ContainsField is the validation function for validating that the current field contains the value of the field
+// specified by the param's value; as a substring for strings and as an element for slices and arrays.
+func containsField(fl FieldLevel) bool {
+	field := bytesAsString(fl.Field())
+
+	needle, _, ok := fl.GetStructFieldOK()
+	if !ok {
+		return false
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		if needle.Kind() != reflect.String {
+			return false
+		}
+		return strings.Contains(field.String(), needle.String())
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < field.Len(); i++ {
+			elem, _, _ := fl.ExtractType(field.Index(i))
+			if valuesEqual(elem, needle) {
+				return true
+			}
+		}
+		return false
+	}
+
+	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+}
+
+// isCoerced is the validation function for the coerce marker, used by StructCoerce, which always passes.
+func isCoerced(fl FieldLevel) bool {
+	return true
+}
+
 // FieldExcludes is the validation function for validating if the current field's value excludes the field specified by the param's value.
 func fieldExcludes(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	currentField, _, ok := fl.GetStructFieldOK()
 	if !ok {
@@ -778,6 +1123,12 @@ func isNeField(fl FieldLevel) bool {
 
 	currentField, currentKind, ok := fl.GetStructFieldOK()
 
+	if ok {
+		if c, numeric := compareNumeric(field, currentField); numeric {
+			return c != 0
+		}
+	}
+
 	if !ok || currentKind != kind {
 		return true
 	}
@@ -1116,6 +1467,115 @@ func isEqCrossStructField(fl FieldLevel) bool {
 	return topField.String() == field.String()
 }
 
+// isLenField is the validation function for validating if the current field's length is equal to the length of the field specified by the param's value.
+func isLenField(fl FieldLevel) bool {
+	l, other, ok := lenFieldLengths(fl)
+	return ok && l == other
+}
+
+// isLtLenField is the validation function for validating if the current field's length is less than the length of the field specified by the param's value.
+func isLtLenField(fl FieldLevel) bool {
+	l, other, ok := lenFieldLengths(fl)
+	return ok && l < other
+}
+
+// isLteLenField is the validation function for validating if the current field's length is less than or equal to the length of the field specified by the param's value.
+func isLteLenField(fl FieldLevel) bool {
+	l, other, ok := lenFieldLengths(fl)
+	return ok && l <= other
+}
+
+// isGtLenField is the validation function for validating if the current field's length is greater than the length of the field specified by the param's value.
+func isGtLenField(fl FieldLevel) bool {
+	l, other, ok := lenFieldLengths(fl)
+	return ok && l > other
+}
+
+// isGteLenField is the validation function for validating if the current field's length is greater than or equal to the length of the field specified by the param's value.
+func isGteLenField(fl FieldLevel) bool {
+	l, other, ok := lenFieldLengths(fl)
+	return ok && l >= other
+}
+
+// isMethodValid is the validation function for validating that the zero argument bool method named by the
+// param's value returns true. The method is looked up on the field's value and, when not found there, on the
+// struct containing the field; methods with a pointer receiver are found on values too.
+func isMethodValid(fl FieldLevel) bool {
+	name := fl.Param()
+
+	if m, ok := boolMethod(fl.Field(), name); ok {
+		return m.Call(nil)[0].Bool()
+	}
+
+	if m, ok := boolMethod(fl.Parent(), name); ok {
+		return m.Call(nil)[0].Bool()
+	}
+
+	panic(fmt.Sprintf("Method '%s' not found on field %s or its parent", name, fl.FieldName()))
+}
+
+// boolMethod returns the method named name of the value, panicking when it isn't a zero argument method
+// returning a single bool.
+func boolMethod(val reflect.Value, name string) (m reflect.Value, ok bool) {
+	if !val.IsValid() {
+		return
+	}
+
+	m = val.MethodByName(name)
+
+	if !m.IsValid() && val.Kind() != reflect.Ptr && val.Kind() != reflect.Interface {
+		ptr := val
+		if val.CanAddr() {
+			ptr = val.Addr()
+		} else {
+			ptr = reflect.New(val.Type())
+			ptr.Elem().Set(val)
+		}
+		m = ptr.MethodByName(name)
+	}
+
+	if !m.IsValid() {
+		return
+	}
+
+	if mt := m.Type(); mt.NumIn() != 0 || mt.NumOut() != 1 || mt.Out(0).Kind() != reflect.Bool {
+		panic(fmt.Sprintf("Method '%s' on %s must take no arguments and return a bool", name, val.Type()))
+	}
+
+	return m, true
+}
+
+// lenFieldLengths returns the length of the current field and of the field specified by the param's value,
+// strings are measured in runes. ok is false when the other field can't be found or has no length.
+func lenFieldLengths(fl FieldLevel) (l int, other int, ok bool) {
+	field := fl.Field()
+
+	switch field.Kind() {
+	case reflect.String:
+		l = utf8.RuneCountInString(field.String())
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
+		l = field.Len()
+	default:
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+
+	currentField, currentKind, found := fl.GetStructFieldOK()
+	if !found {
+		return
+	}
+
+	switch currentKind {
+	case reflect.String:
+		other = utf8.RuneCountInString(currentField.String())
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
+		other = currentField.Len()
+	default:
+		return
+	}
+
+	return l, other, true
+}
+
 // IsEqField is the validation function for validating if the current field's value is equal to the field specified by the param's value.
 func isEqField(fl FieldLevel) bool {
 
@@ -1123,6 +1583,12 @@ func isEqField(fl FieldLevel) bool {
 	kind := field.Kind()
 
 	currentField, currentKind, ok := fl.GetStructFieldOK()
+	if ok {
+		if c, numeric := compareNumeric(field, currentField); numeric {
+			return c == 0
+		}
+	}
+
 	if !ok || currentKind != kind {
 		return false
 	}
@@ -1210,7 +1676,7 @@ func isEq(fl FieldLevel) bool {
 // isPostcodeByIso3166Alpha2 validates by value which is country code in iso 3166 alpha 2
 // example: `postcode_iso3166_alpha2=US`
 func isPostcodeByIso3166Alpha2(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 	param := fl.Param()
 
 	reg, found := postCodeRegexDict[param]
@@ -1224,7 +1690,7 @@ func isPostcodeByIso3166Alpha2(fl FieldLevel) bool {
 // isPostcodeByIso3166Alpha2 validates by field which represents for a value of country code in iso 3166 alpha 2
 // example: `postcode_iso3166_alpha2_field=CountryCode`
 func isPostcodeByIso3166Alpha2Field(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 	params := parseOneOfParam2(fl.Param())
 
 	if len(params) != 1 {
@@ -1250,18 +1716,18 @@ func isPostcodeByIso3166Alpha2Field(fl FieldLevel) bool {
 
 // IsBase64 is the validation function for validating if the current field's value is a valid base 64.
 func isBase64(fl FieldLevel) bool {
-	return base64Regex.MatchString(fl.Field().String())
+	return base64Regex.MatchString(fieldString(fl))
 }
 
 // IsBase64URL is the validation function for validating if the current field's value is a valid base64 URL safe string.
 func isBase64URL(fl FieldLevel) bool {
-	return base64URLRegex.MatchString(fl.Field().String())
+	return base64URLRegex.MatchString(fieldString(fl))
 }
 
 // IsURI is the validation function for validating if the current field's value is a valid URI.
 func isURI(fl FieldLevel) bool {
 
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	switch field.Kind() {
 
@@ -1290,7 +1756,7 @@ func isURI(fl FieldLevel) bool {
 // IsURL is the validation function for validating if the current field's value is a valid URL.
 func isURL(fl FieldLevel) bool {
 
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	switch field.Kind() {
 
@@ -1323,7 +1789,7 @@ func isURL(fl FieldLevel) bool {
 
 // isUrnRFC2141 is the validation function for validating if the current field's value is a valid URN as per RFC 2141.
 func isUrnRFC2141(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	switch field.Kind() {
 
@@ -1341,7 +1807,7 @@ func isUrnRFC2141(fl FieldLevel) bool {
 
 // IsFile is the validation function for validating if the current field's value is a valid file path.
 func isFile(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	switch field.Kind() {
 	case reflect.String:
@@ -1356,44 +1822,188 @@ func isFile(fl FieldLevel) bool {
 	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
 }
 
+// isFilePath is the validation function for validating if the current field's value is syntactically a valid file
+// path for the operating system, without touching the filesystem.
+func isFilePath(fl FieldLevel) bool {
+	field := bytesAsString(fl.Field())
+
+	if field.Kind() == reflect.String {
+		return isPathSyntax(field.String(), runtime.GOOS == "windows")
+	}
+
+	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+}
+
+// isPathSyntax returns whether the path is non-empty, valid UTF-8 and without NUL bytes, the only invalid
+// characters on Unix, and for Windows also without control characters or any of <>:"|?* following the volume name.
+func isPathSyntax(path string, windows bool) bool {
+	if len(path) == 0 || !utf8.ValidString(path) || strings.IndexByte(path, 0) != -1 {
+		return false
+	}
+
+	if windows {
+		path = path[len(windowsVolumeName(path)):]
+
+		for _, r := range path {
+			if r < 0x20 || strings.ContainsRune(`<>:"|?*`, r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// windowsVolumeName returns the leading drive letter, eg. C:, or UNC volume name, eg. \\host\share, of a Windows
+// path regardless of the operating system.
+func windowsVolumeName(path string) string {
+	if len(path) >= 2 && path[1] == ':' && ('a' <= path[0] && path[0] <= 'z' || 'A' <= path[0] && path[0] <= 'Z') {
+		return path[:2]
+	}
+
+	if len(path) > 2 && (path[0] == '\\' || path[0] == '/') && (path[1] == '\\' || path[1] == '/') {
+		// \\host\share
+		rest := path[2:]
+		idx := strings.IndexAny(rest, `\/`)
+		if idx <= 0 {
+			return ""
+		}
+		share := rest[idx+1:]
+		if end := strings.IndexAny(share, `\/`); end != -1 {
+			share = share[:end]
+		}
+		if len(share) == 0 {
+			return ""
+		}
+		return path[:2+idx+1+len(share)]
+	}
+
+	return ""
+}
+
+// defaultPasswordPolicy is the policy of the password validation when no param is given.
+const defaultPasswordPolicy = "min:8;upper:1;lower:1;digit:1"
+
+// isPassword is the validation function for validating if the current field's value satisfies the password policy
+// specified by the param's value, a list of minimum counts for the classes min, the length in characters, upper,
+// lower, digit and special. On failure the error's param is the first requirement which wasn't met.
+func isPassword(fl FieldLevel) bool {
+	field := bytesAsString(fl.Field())
+
+	if field.Kind() != reflect.String {
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+
+	param := fl.Param()
+	if len(param) == 0 {
+		param = defaultPasswordPolicy
+	}
+
+	var length, upper, lower, digit, special int64
+
+	for _, r := range field.String() {
+		length++
+
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		case unicode.IsDigit(r):
+			digit++
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			special++
+		}
+	}
+
+	// requirements are separated by ';' as ',' separates the validations of the tag
+	for _, req := range strings.Split(param, ";") {
+		vals := strings.SplitN(req, ":", 2)
+		if len(vals) != 2 {
+			panic(fmt.Sprintf("Bad password requirement '%s'", req))
+		}
+
+		n, err := strconv.ParseInt(vals[1], 0, 64)
+		if err != nil {
+			panic(fmt.Sprintf("Bad password requirement '%s'", req))
+		}
+
+		var count int64
+
+		switch vals[0] {
+		case "min":
+			count = length
+		case "upper":
+			count = upper
+		case "lower":
+			count = lower
+		case "digit":
+			count = digit
+		case "special":
+			count = special
+		default:
+			panic(fmt.Sprintf("Bad password requirement '%s'", req))
+		}
+
+		if count < n {
+			fl.(*validate).errParam = req
+			return false
+		}
+	}
+
+	return true
+}
+
+// isURIPath is the validation function for validating if the current field's value is a valid URI path, as defined
+// by RFC 3986, consisting of path segments of unreserved, sub-delims, ':', '@' and percent-encoded characters.
+func isURIPath(fl FieldLevel) bool {
+	field := bytesAsString(fl.Field())
+
+	if field.Kind() == reflect.String {
+		return uriPathRegex.MatchString(field.String())
+	}
+
+	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+}
+
 // IsE164 is the validation function for validating if the current field's value is a valid e.164 formatted phone number.
 func isE164(fl FieldLevel) bool {
-	return e164Regex.MatchString(fl.Field().String())
+	return e164Regex.MatchString(fieldString(fl))
 }
 
 // IsEmail is the validation function for validating if the current field's value is a valid email address.
 func isEmail(fl FieldLevel) bool {
-	return emailRegex.MatchString(fl.Field().String())
+	return emailRegex.MatchString(fieldString(fl))
 }
 
 // IsHSLA is the validation function for validating if the current field's value is a valid HSLA color.
 func isHSLA(fl FieldLevel) bool {
-	return hslaRegex.MatchString(fl.Field().String())
+	return hslaRegex.MatchString(fieldString(fl))
 }
 
 // IsHSL is the validation function for validating if the current field's value is a valid HSL color.
 func isHSL(fl FieldLevel) bool {
-	return hslRegex.MatchString(fl.Field().String())
+	return hslRegex.MatchString(fieldString(fl))
 }
 
 // IsRGBA is the validation function for validating if the current field's value is a valid RGBA color.
 func isRGBA(fl FieldLevel) bool {
-	return rgbaRegex.MatchString(fl.Field().String())
+	return rgbaRegex.MatchString(fieldString(fl))
 }
 
 // IsRGB is the validation function for validating if the current field's value is a valid RGB color.
 func isRGB(fl FieldLevel) bool {
-	return rgbRegex.MatchString(fl.Field().String())
+	return rgbRegex.MatchString(fieldString(fl))
 }
 
 // IsHEXColor is the validation function for validating if the current field's value is a valid HEX color.
 func isHEXColor(fl FieldLevel) bool {
-	return hexColorRegex.MatchString(fl.Field().String())
+	return hexColorRegex.MatchString(fieldString(fl))
 }
 
 // IsHexadecimal is the validation function for validating if the current field's value is a valid hexadecimal.
 func isHexadecimal(fl FieldLevel) bool {
-	return hexadecimalRegex.MatchString(fl.Field().String())
+	return hexadecimalRegex.MatchString(fieldString(fl))
 }
 
 // IsNumber is the validation function for validating if the current field's value is a valid number.
@@ -1402,7 +2012,7 @@ func isNumber(fl FieldLevel) bool {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr, reflect.Float32, reflect.Float64:
 		return true
 	default:
-		return numberRegex.MatchString(fl.Field().String())
+		return numberRegex.MatchString(fieldString(fl))
 	}
 }
 
@@ -1412,28 +2022,74 @@ func isNumeric(fl FieldLevel) bool {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr, reflect.Float32, reflect.Float64:
 		return true
 	default:
-		return numericRegex.MatchString(fl.Field().String())
+		return numericRegex.MatchString(fieldString(fl))
+	}
+}
+
+// isInteger is the validation function for validating if the current field's kind is an integer, signed or
+// unsigned; unlike number and numeric strings never pass.
+func isInteger(fl FieldLevel) bool {
+	switch bytesAsString(fl.Field()).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDecimal is the validation function for validating if the current field's kind is a float; unlike number
+// and numeric strings never pass.
+func isDecimal(fl FieldLevel) bool {
+	switch bytesAsString(fl.Field()).Kind() {
+	case reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
 	}
 }
 
 // IsAlphanum is the validation function for validating if the current field's value is a valid alphanumeric value.
 func isAlphanum(fl FieldLevel) bool {
-	return alphaNumericRegex.MatchString(fl.Field().String())
+	return alphaNumericRegex.MatchString(fieldString(fl))
 }
 
 // IsAlpha is the validation function for validating if the current field's value is a valid alpha value.
 func isAlpha(fl FieldLevel) bool {
-	return alphaRegex.MatchString(fl.Field().String())
+	return alphaRegex.MatchString(fieldString(fl))
 }
 
 // IsAlphanumUnicode is the validation function for validating if the current field's value is a valid alphanumeric unicode value.
 func isAlphanumUnicode(fl FieldLevel) bool {
-	return alphaUnicodeNumericRegex.MatchString(fl.Field().String())
+	return alphaUnicodeNumericRegex.MatchString(fieldString(fl))
 }
 
 // IsAlphaUnicode is the validation function for validating if the current field's value is a valid alpha unicode value.
 func isAlphaUnicode(fl FieldLevel) bool {
-	return alphaUnicodeRegex.MatchString(fl.Field().String())
+	return alphaUnicodeRegex.MatchString(fieldString(fl))
+}
+
+// isAlphaSpace is the validation function for validating if the current field's value contains only alpha
+// characters and spaces.
+func isAlphaSpace(fl FieldLevel) bool {
+	return alphaSpaceRegex.MatchString(fieldString(fl))
+}
+
+// isAlphanumSpace is the validation function for validating if the current field's value contains only
+// alphanumeric characters and spaces.
+func isAlphanumSpace(fl FieldLevel) bool {
+	return alphaNumericSpaceRegex.MatchString(fieldString(fl))
+}
+
+// isAlphaSpaceUnicode is the validation function for validating if the current field's value contains only
+// unicode letters and spaces.
+func isAlphaSpaceUnicode(fl FieldLevel) bool {
+	return alphaUnicodeSpaceRegex.MatchString(fieldString(fl))
+}
+
+// isAlphanumSpaceUnicode is the validation function for validating if the current field's value contains only
+// unicode letters, unicode numbers and spaces.
+func isAlphanumSpaceUnicode(fl FieldLevel) bool {
+	return alphaUnicodeNumSpaceRegex.MatchString(fieldString(fl))
 }
 
 // isDefault is the opposite of required aka hasValue
@@ -1444,14 +2100,42 @@ func isDefault(fl FieldLevel) bool {
 // HasValue is the validation function for validating if the current field's value is not the default static value.
 func hasValue(fl FieldLevel) bool {
 	field := fl.Field()
+
+	if v := fl.(*validate); len(v.v.zeroFuncs) > 0 && field.IsValid() && !v.fldIsPointer {
+		if isZero, ok := v.v.zeroFuncs[field.Type()]; ok {
+			return !isZero(field)
+		}
+	}
+
 	switch field.Kind() {
 	case reflect.Slice, reflect.Map, reflect.Ptr, reflect.Interface, reflect.Chan, reflect.Func:
 		return !field.IsNil()
+	case reflect.Float32, reflect.Float64:
+		// compared by value so that -0 is zero, as IsZero compares the bits
+		return fl.(*validate).fldIsPointer || field.Float() != 0
 	default:
-		if fl.(*validate).fldIsPointer && field.Interface() != nil {
-			return true
+		if v := fl.(*validate); v.fldIsPointer && field.IsValid() {
+			return !v.v.emptyStringAsNil || field.Kind() != reflect.String || field.Len() > 0
 		}
-		return field.IsValid() && field.Interface() != reflect.Zero(field.Type()).Interface()
+		// rather than comparing to the zero value, which boxes both, and structs may hold incomparable fields
+		return field.IsValid() && !field.IsZero()
+	}
+}
+
+// notBlank is the validation function for validating if the current field has a value
+// or length greater than zero, or is not a space only string.
+func notBlank(fl FieldLevel) bool {
+	field := fl.Field()
+
+	switch field.Kind() {
+	case reflect.String:
+		return len(strings.TrimSpace(field.String())) > 0
+	case reflect.Chan, reflect.Map, reflect.Slice, reflect.Array:
+		return field.Len() > 0
+	case reflect.Ptr, reflect.Interface, reflect.Func:
+		return !field.IsNil()
+	default:
+		return field.IsValid() && !field.IsZero()
 	}
 }
 
@@ -1523,6 +2207,21 @@ func requiredIf(fl FieldLevel) bool {
 	return hasValue(fl)
 }
 
+// requiredIfAny is the validation function
+// The field under validation must be present and not empty if any of the other specified fields are equal to the value following the specified field.
+func requiredIfAny(fl FieldLevel) bool {
+	params := parseOneOfParam2(fl.Param())
+	if len(params) == 0 || len(params)%2 != 0 {
+		panic(fmt.Sprintf("Bad param number for required_if_any %s", fl.FieldName()))
+	}
+	for i := 0; i < len(params); i += 2 {
+		if requireCheckFieldValue(fl, params[i], params[i+1], false) {
+			return hasValue(fl)
+		}
+	}
+	return true
+}
+
 // requiredUnless is the validation function
 // The field under validation must be present and not empty only unless all the other specified fields are equal to the value following with the specified field.
 func requiredUnless(fl FieldLevel) bool {
@@ -1539,6 +2238,38 @@ func requiredUnless(fl FieldLevel) bool {
 	return hasValue(fl)
 }
 
+// skipIf is the validation function
+// The remaining validations of the field are skipped if all the other specified fields are equal to the value
+// following the specified field. Returns false when the remaining validations should be skipped.
+func skipIf(fl FieldLevel) bool {
+	params := parseOneOfParam2(fl.Param())
+	if len(params)%2 != 0 {
+		panic(fmt.Sprintf("Bad param number for skip_if %s", fl.FieldName()))
+	}
+	for i := 0; i < len(params); i += 2 {
+		if !requireCheckFieldValue(fl, params[i], params[i+1], false) {
+			return true
+		}
+	}
+	return false
+}
+
+// skipUnless is the validation function
+// The remaining validations of the field are skipped unless all the other specified fields are equal to the value
+// following the specified field. Returns false when the remaining validations should be skipped.
+func skipUnless(fl FieldLevel) bool {
+	params := parseOneOfParam2(fl.Param())
+	if len(params)%2 != 0 {
+		panic(fmt.Sprintf("Bad param number for skip_unless %s", fl.FieldName()))
+	}
+	for i := 0; i < len(params); i += 2 {
+		if !requireCheckFieldValue(fl, params[i], params[i+1], false) {
+			return false
+		}
+	}
+	return true
+}
+
 // ExcludedWith is the validation function
 // The field under validation must not be present or is empty if any of the other specified fields are present.
 func excludedWith(fl FieldLevel) bool {
@@ -1629,6 +2360,29 @@ func requiredWithoutAll(fl FieldLevel) bool {
 	return hasValue(fl)
 }
 
+// requiredOneOf is the validation function validating at least one of the fields specified by the param, resolved
+// within the parent struct, has a value.
+func requiredOneOf(fl FieldLevel) bool {
+	return countPresentFields(fl) > 0
+}
+
+// requiredExactlyOneOf is the validation function validating exactly one of the fields specified by the param,
+// resolved within the parent struct, has a value.
+func requiredExactlyOneOf(fl FieldLevel) bool {
+	return countPresentFields(fl) == 1
+}
+
+// countPresentFields returns the number of the fields specified by the param having a value, fields which can't
+// be found counting as not present.
+func countPresentFields(fl FieldLevel) (n int) {
+	for _, param := range parseOneOfParam2(fl.Param()) {
+		if !requireCheckFieldKind(fl, param, true) {
+			n++
+		}
+	}
+	return
+}
+
 // IsGteField is the validation function for validating if the current field's value is greater than or equal to the field specified by the param's value.
 func isGteField(fl FieldLevel) bool {
 
@@ -1636,6 +2390,12 @@ func isGteField(fl FieldLevel) bool {
 	kind := field.Kind()
 
 	currentField, currentKind, ok := fl.GetStructFieldOK()
+	if ok {
+		if c, numeric := compareNumeric(field, currentField); numeric {
+			return c >= 0
+		}
+	}
+
 	if !ok || currentKind != kind {
 		return false
 	}
@@ -1683,6 +2443,12 @@ func isGtField(fl FieldLevel) bool {
 	kind := field.Kind()
 
 	currentField, currentKind, ok := fl.GetStructFieldOK()
+	if ok {
+		if c, numeric := compareNumeric(field, currentField); numeric {
+			return c > 0
+		}
+	}
+
 	if !ok || currentKind != kind {
 		return false
 	}
@@ -1760,7 +2526,7 @@ func isGte(fl FieldLevel) bool {
 
 		if field.Type() == timeType {
 
-			now := time.Now().UTC()
+			now := fl.(*validate).v.now().UTC()
 			t := field.Interface().(time.Time)
 
 			return t.After(now) || t.Equal(now)
@@ -1770,6 +2536,71 @@ func isGte(fl FieldLevel) bool {
 	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
 }
 
+// isFuture is the validation function for validating if the current field's time.Time value is after the current
+// time, tolerating a clock skew of the duration specified by the param's value. The zero time.Time is accepted.
+func isFuture(fl FieldLevel) bool {
+	t, tolerance := timeWithTolerance(fl)
+	return t.IsZero() || t.After(fl.(*validate).v.now().Add(-tolerance))
+}
+
+// isPast is the validation function for validating if the current field's time.Time value is before the current
+// time, tolerating a clock skew of the duration specified by the param's value. The zero time.Time is accepted.
+func isPast(fl FieldLevel) bool {
+	t, tolerance := timeWithTolerance(fl)
+	return t.IsZero() || t.Before(fl.(*validate).v.now().Add(tolerance))
+}
+
+// hasMinAge is the validation function for validating if the age, in whole years, of the current field's time.Time
+// birthdate value is at least the param's value at the current time. The zero time.Time is accepted.
+func hasMinAge(fl FieldLevel) bool {
+	t, age := ageInYears(fl)
+	return t.IsZero() || age >= asInt(fl.Param())
+}
+
+// hasMaxAge is the validation function for validating if the age, in whole years, of the current field's time.Time
+// birthdate value is at most the param's value at the current time. The zero time.Time is accepted.
+func hasMaxAge(fl FieldLevel) bool {
+	t, age := ageInYears(fl)
+	return t.IsZero() || age <= asInt(fl.Param())
+}
+
+// ageInYears returns the current field's time.Time value and the number of whole years elapsed since it, as of the
+// current time in the value's location. A year is only complete once its month and day are reached, so those born
+// on the 29th of February come of age on the 1st of March in non leap years.
+func ageInYears(fl FieldLevel) (t time.Time, age int64) {
+	field := fl.Field()
+
+	if field.Type() != timeType {
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+
+	t = field.Interface().(time.Time)
+	now := fl.(*validate).v.now().In(t.Location())
+
+	age = int64(now.Year() - t.Year())
+	if now.Month() < t.Month() || (now.Month() == t.Month() && now.Day() < t.Day()) {
+		age--
+	}
+
+	return t, age
+}
+
+// timeWithTolerance returns the current field's time.Time value and the duration specified by the param's value,
+// zero when no param is given.
+func timeWithTolerance(fl FieldLevel) (t time.Time, tolerance time.Duration) {
+	field := fl.Field()
+
+	if field.Type() != timeType {
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+
+	if param := fl.Param(); len(param) > 0 {
+		tolerance = time.Duration(asIntFromTimeDuration(param))
+	}
+
+	return field.Interface().(time.Time), tolerance
+}
+
 // IsGt is the validation function for validating if the current field's value is greater than the param's value.
 func isGt(fl FieldLevel) bool {
 
@@ -1806,16 +2637,54 @@ func isGt(fl FieldLevel) bool {
 
 		if field.Type() == timeType {
 
-			return field.Interface().(time.Time).After(time.Now().UTC())
+			return field.Interface().(time.Time).After(fl.(*validate).v.now().UTC())
 		}
 	}
 
 	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
 }
 
+// stringerLength returns the length in runes of the field's String() output, when RegisterStringerLength has been
+// called and the field is a fmt.Stringer without a length or comparison of its own; see RegisterStringerLength.
+func stringerLength(fl FieldLevel) (int64, bool) {
+	v, ok := fl.(*validate)
+	if !ok || !v.v.stringerLength {
+		return 0, false
+	}
+
+	field := fl.Field()
+
+	switch field.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Invalid:
+		return 0, false
+	}
+
+	if typ := field.Type(); typ == timeType || typ == timeDurationType {
+		return 0, false
+	}
+
+	if !field.CanInterface() {
+		return 0, false
+	}
+
+	s, ok := field.Interface().(fmt.Stringer)
+	if !ok && field.CanAddr() {
+		s, ok = field.Addr().Interface().(fmt.Stringer)
+	}
+	if !ok {
+		return 0, false
+	}
+
+	return int64(utf8.RuneCountInString(s.String())), true
+}
+
 // HasLengthOf is the validation function for validating if the current field's value is equal to the param's value.
 func hasLengthOf(fl FieldLevel) bool {
 
+	if n, ok := stringerLength(fl); ok {
+		return n == asInt(fl.Param())
+	}
+
 	field := fl.Field()
 	param := fl.Param()
 
@@ -1852,6 +2721,9 @@ func hasLengthOf(fl FieldLevel) bool {
 
 // HasMinOf is the validation function for validating if the current field's value is greater than or equal to the param's value.
 func hasMinOf(fl FieldLevel) bool {
+	if n, ok := stringerLength(fl); ok {
+		return n >= asInt(fl.Param())
+	}
 	return isGte(fl)
 }
 
@@ -1862,6 +2734,12 @@ func isLteField(fl FieldLevel) bool {
 	kind := field.Kind()
 
 	currentField, currentKind, ok := fl.GetStructFieldOK()
+	if ok {
+		if c, numeric := compareNumeric(field, currentField); numeric {
+			return c <= 0
+		}
+	}
+
 	if !ok || currentKind != kind {
 		return false
 	}
@@ -1909,6 +2787,12 @@ func isLtField(fl FieldLevel) bool {
 	kind := field.Kind()
 
 	currentField, currentKind, ok := fl.GetStructFieldOK()
+	if ok {
+		if c, numeric := compareNumeric(field, currentField); numeric {
+			return c < 0
+		}
+	}
+
 	if !ok || currentKind != kind {
 		return false
 	}
@@ -1986,7 +2870,7 @@ func isLte(fl FieldLevel) bool {
 
 		if field.Type() == timeType {
 
-			now := time.Now().UTC()
+			now := fl.(*validate).v.now().UTC()
 			t := field.Interface().(time.Time)
 
 			return t.Before(now) || t.Equal(now)
@@ -2033,7 +2917,7 @@ func isLt(fl FieldLevel) bool {
 
 		if field.Type() == timeType {
 
-			return field.Interface().(time.Time).Before(time.Now().UTC())
+			return field.Interface().(time.Time).Before(fl.(*validate).v.now().UTC())
 		}
 	}
 
@@ -2042,6 +2926,9 @@ func isLt(fl FieldLevel) bool {
 
 // HasMaxOf is the validation function for validating if the current field's value is less than or equal to the param's value.
 func hasMaxOf(fl FieldLevel) bool {
+	if n, ok := stringerLength(fl); ok {
+		return n <= asInt(fl.Param())
+	}
 	return isLte(fl)
 }
 
@@ -2052,7 +2939,7 @@ func isTCP4AddrResolvable(fl FieldLevel) bool {
 		return false
 	}
 
-	_, err := net.ResolveTCPAddr("tcp4", fl.Field().String())
+	_, err := net.ResolveTCPAddr("tcp4", fieldString(fl))
 	return err == nil
 }
 
@@ -2063,7 +2950,7 @@ func isTCP6AddrResolvable(fl FieldLevel) bool {
 		return false
 	}
 
-	_, err := net.ResolveTCPAddr("tcp6", fl.Field().String())
+	_, err := net.ResolveTCPAddr("tcp6", fieldString(fl))
 
 	return err == nil
 }
@@ -2075,7 +2962,7 @@ func isTCPAddrResolvable(fl FieldLevel) bool {
 		return false
 	}
 
-	_, err := net.ResolveTCPAddr("tcp", fl.Field().String())
+	_, err := net.ResolveTCPAddr("tcp", fieldString(fl))
 
 	return err == nil
 }
@@ -2087,7 +2974,7 @@ func isUDP4AddrResolvable(fl FieldLevel) bool {
 		return false
 	}
 
-	_, err := net.ResolveUDPAddr("udp4", fl.Field().String())
+	_, err := net.ResolveUDPAddr("udp4", fieldString(fl))
 
 	return err == nil
 }
@@ -2099,7 +2986,7 @@ func isUDP6AddrResolvable(fl FieldLevel) bool {
 		return false
 	}
 
-	_, err := net.ResolveUDPAddr("udp6", fl.Field().String())
+	_, err := net.ResolveUDPAddr("udp6", fieldString(fl))
 
 	return err == nil
 }
@@ -2111,7 +2998,7 @@ func isUDPAddrResolvable(fl FieldLevel) bool {
 		return false
 	}
 
-	_, err := net.ResolveUDPAddr("udp", fl.Field().String())
+	_, err := net.ResolveUDPAddr("udp", fieldString(fl))
 
 	return err == nil
 }
@@ -2123,7 +3010,7 @@ func isIP4AddrResolvable(fl FieldLevel) bool {
 		return false
 	}
 
-	_, err := net.ResolveIPAddr("ip4", fl.Field().String())
+	_, err := net.ResolveIPAddr("ip4", fieldString(fl))
 
 	return err == nil
 }
@@ -2135,7 +3022,7 @@ func isIP6AddrResolvable(fl FieldLevel) bool {
 		return false
 	}
 
-	_, err := net.ResolveIPAddr("ip6", fl.Field().String())
+	_, err := net.ResolveIPAddr("ip6", fieldString(fl))
 
 	return err == nil
 }
@@ -2147,7 +3034,7 @@ func isIPAddrResolvable(fl FieldLevel) bool {
 		return false
 	}
 
-	_, err := net.ResolveIPAddr("ip", fl.Field().String())
+	_, err := net.ResolveIPAddr("ip", fieldString(fl))
 
 	return err == nil
 }
@@ -2155,7 +3042,7 @@ func isIPAddrResolvable(fl FieldLevel) bool {
 // IsUnixAddrResolvable is the validation function for validating if the field's value is a resolvable unix address.
 func isUnixAddrResolvable(fl FieldLevel) bool {
 
-	_, err := net.ResolveUnixAddr("unix", fl.Field().String())
+	_, err := net.ResolveUnixAddr("unix", fieldString(fl))
 
 	return err == nil
 }
@@ -2189,26 +3076,56 @@ func isIP6Addr(fl FieldLevel) bool {
 }
 
 func isHostnameRFC952(fl FieldLevel) bool {
-	return hostnameRegexRFC952.MatchString(fl.Field().String())
+	val := fieldString(fl)
+	return hasValidHostnameLength(val) && hostnameRegexRFC952.MatchString(val)
 }
 
 func isHostnameRFC1123(fl FieldLevel) bool {
-	return hostnameRegexRFC1123.MatchString(fl.Field().String())
+	val := fieldString(fl)
+	return hasValidHostnameLength(val) && hostnameRegexRFC1123.MatchString(val)
 }
 
 func isFQDN(fl FieldLevel) bool {
-	val := fl.Field().String()
+	val := fieldString(fl)
 
 	if val == "" {
 		return false
 	}
 
-	return fqdnRegexRFC1123.MatchString(val)
+	return hasValidHostnameLength(val) && fqdnRegexRFC1123.MatchString(val)
+}
+
+func isFQDNRelaxed(fl FieldLevel) bool {
+	val := fieldString(fl)
+
+	if val == "" {
+		return false
+	}
+
+	return hasValidHostnameLength(val) && fqdnRelaxedRegexRFC1123.MatchString(val)
+}
+
+// hasValidHostnameLength reports whether the hostname, ignoring a single trailing dot,
+// is at most 253 characters long and each of its labels at most 63 characters long.
+func hasValidHostnameLength(val string) bool {
+	val = strings.TrimSuffix(val, ".")
+
+	if len(val) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(val, ".") {
+		if len(label) > 63 {
+			return false
+		}
+	}
+
+	return true
 }
 
 // IsDir is the validation function for validating if the current field's value is a valid directory.
 func isDir(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	if field.Kind() == reflect.String {
 		fileInfo, err := os.Stat(field.String())
@@ -2226,9 +3143,15 @@ func isDir(fl FieldLevel) bool {
 func isJSON(fl FieldLevel) bool {
 	field := fl.Field()
 
-	if field.Kind() == reflect.String {
+	switch field.Kind() {
+	case reflect.String:
 		val := field.String()
 		return json.Valid([]byte(val))
+	case reflect.Slice:
+		// []byte and named byte slices such as json.RawMessage
+		if isBytes(field) {
+			return json.Valid(field.Bytes())
+		}
 	}
 
 	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
@@ -2236,7 +3159,7 @@ func isJSON(fl FieldLevel) bool {
 
 // isHostnamePort validates a <dns>:<port> combination for fields typically used for socket address.
 func isHostnamePort(fl FieldLevel) bool {
-	val := fl.Field().String()
+	val := fieldString(fl)
 	host, port, err := net.SplitHostPort(val)
 	if err != nil {
 		return false
@@ -2255,7 +3178,7 @@ func isHostnamePort(fl FieldLevel) bool {
 
 // isLowercase is the validation function for validating if the current field's value is a lowercase string.
 func isLowercase(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	if field.Kind() == reflect.String {
 		if field.String() == "" {
@@ -2269,7 +3192,7 @@ func isLowercase(fl FieldLevel) bool {
 
 // isUppercase is the validation function for validating if the current field's value is an uppercase string.
 func isUppercase(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	if field.Kind() == reflect.String {
 		if field.String() == "" {
@@ -2283,7 +3206,7 @@ func isUppercase(fl FieldLevel) bool {
 
 // isDatetime is the validation function for validating if the current field's value is a valid datetime string.
 func isDatetime(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 	param := fl.Param()
 
 	if field.Kind() == reflect.String {
@@ -2295,23 +3218,113 @@ func isDatetime(fl FieldLevel) bool {
 	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
 }
 
+// isDatetimeAny is the validation function for validating if the current field's value is a valid datetime in any
+// of the '|' separated layouts of the param.
+func isDatetimeAny(fl FieldLevel) bool {
+	field := bytesAsString(fl.Field())
+	param := fl.Param()
+
+	if field.Kind() == reflect.String {
+		val := field.String()
+
+		for {
+			layout := param
+			idx := strings.Index(param, orSeparator)
+			if idx != -1 {
+				layout = param[:idx]
+			}
+
+			if _, err := time.Parse(layout, val); err == nil {
+				return true
+			}
+
+			if idx == -1 {
+				return false
+			}
+			param = param[idx+len(orSeparator):]
+		}
+	}
+
+	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+}
+
+// isDatetimeLayout returns the validation function for validating if the current field's value is a valid datetime
+// in the layout, as datetime=layout does.
+func isDatetimeLayout(layout string) Func {
+	return func(fl FieldLevel) bool {
+		field := bytesAsString(fl.Field())
+
+		if field.Kind() == reflect.String {
+			_, err := time.Parse(layout, field.String())
+
+			return err == nil
+		}
+
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+}
+
 // isTimeZone is the validation function for validating if the current field's value is a valid time zone string.
 func isTimeZone(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	if field.Kind() == reflect.String {
-		// empty value is converted to UTC by time.LoadLocation but disallow it as it is not a valid time zone name
-		if field.String() == "" {
+		tz := field.String()
+
+		// empty value is converted to UTC by time.LoadLocation but disallow it as it is not a valid time zone name;
+		// "Local", the system's time zone, is accepted as "UTC" is
+		if tz == "" {
 			return false
 		}
 
-		// Local value is converted to the current system time zone by time.LoadLocation but disallow it as it is not a valid time zone name
-		if strings.ToLower(field.String()) == "local" {
+		timeZoneCacheRWLock.RLock()
+		_, ok := timeZoneCache[tz]
+		timeZoneCacheRWLock.RUnlock()
+		if ok {
+			return true
+		}
+
+		// the names time.LoadLocation resolves, at most the few hundred of the time zone database, are cached so
+		// that it is read once per name; unknown names aren't so that input can't grow the cache
+		if _, err := time.LoadLocation(tz); err != nil {
 			return false
 		}
 
-		_, err := time.LoadLocation(field.String())
-		return err == nil
+		timeZoneCacheRWLock.Lock()
+		timeZoneCache[tz] = struct{}{}
+		timeZoneCacheRWLock.Unlock()
+		return true
+	}
+
+	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+}
+
+// isRegexp is the validation function for validating if the current field's value is a regular expression pattern
+// compiling using regexp.Compile, or a *regexp.Regexp.
+func isRegexp(fl FieldLevel) bool {
+	field := bytesAsString(fl.Field())
+
+	switch field.Kind() {
+	case reflect.String:
+		pattern := field.String()
+		if regexpCache.Contains(pattern) {
+			return true
+		}
+
+		// only successful compilations are cached, invalid patterns are rare and would just evict valid ones
+		if _, err := regexp.Compile(pattern); err != nil {
+			return false
+		}
+
+		regexpCache.Add(pattern)
+		return true
+
+	case reflect.Struct:
+		// a non-nil *regexp.Regexp, nil ones fail prior to being validated, is compiled already; regexp.Regexp
+		// is validated as a value, like time.Time, so its tags run rather than being descended into
+		if field.Type() == regexpType {
+			return true
+		}
 	}
 
 	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
@@ -2319,19 +3332,19 @@ func isTimeZone(fl FieldLevel) bool {
 
 // isIso3166Alpha2 is the validation function for validating if the current field's value is a valid iso3166-1 alpha-2 country code.
 func isIso3166Alpha2(fl FieldLevel) bool {
-	val := fl.Field().String()
+	val := fieldString(fl)
 	return iso3166_1_alpha2[val]
 }
 
 // isIso3166Alpha2 is the validation function for validating if the current field's value is a valid iso3166-1 alpha-3 country code.
 func isIso3166Alpha3(fl FieldLevel) bool {
-	val := fl.Field().String()
+	val := fieldString(fl)
 	return iso3166_1_alpha3[val]
 }
 
 // isIso3166Alpha2 is the validation function for validating if the current field's value is a valid iso3166-1 alpha-numeric country code.
 func isIso3166AlphaNumeric(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	var code int
 	switch field.Kind() {
@@ -2347,7 +3360,7 @@ func isIso3166AlphaNumeric(fl FieldLevel) bool {
 
 // isBCP47LanguageTag is the validation function for validating if the current field's value is a valid BCP 47 language tag, as parsed by language.Parse
 func isBCP47LanguageTag(fl FieldLevel) bool {
-	field := fl.Field()
+	field := bytesAsString(fl.Field())
 
 	if field.Kind() == reflect.String {
 		_, err := language.Parse(field.String())
@@ -2359,7 +3372,7 @@ func isBCP47LanguageTag(fl FieldLevel) bool {
 
 // isIsoBicFormat is the validation function for validating if the current field's value is a valid Business Identifier Code (SWIFT code), defined in ISO 9362
 func isIsoBicFormat(fl FieldLevel) bool {
-	bicString := fl.Field().String()
+	bicString := fieldString(fl)
 
 	return bicRegex.MatchString(bicString)
 }
@@ -3,6 +3,9 @@ package validator
 import (
 	"bytes"
 	sql "database/sql/driver"
+	"reflect"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 )
@@ -888,6 +891,51 @@ func BenchmarkStructSimpleSuccess(b *testing.B) {
 	}
 }
 
+func BenchmarkValidSimpleSuccess(b *testing.B) {
+	validate := New()
+	type Foo struct {
+		StringValue string `validate:"min=5,max=10"`
+		IntValue    int    `validate:"min=5,max=10"`
+	}
+
+	validFoo := &Foo{StringValue: "Foobar", IntValue: 7}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.Valid(validFoo)
+	}
+}
+
+func BenchmarkValidSimpleFailure(b *testing.B) {
+	validate := New()
+	type Foo struct {
+		StringValue string `validate:"min=5,max=10"`
+		IntValue    int    `validate:"min=5,max=10"`
+	}
+
+	invalidFoo := &Foo{StringValue: "Fo", IntValue: 3}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.Valid(invalidFoo)
+	}
+}
+
+func BenchmarkStructNSimpleFailure(b *testing.B) {
+	validate := New()
+	type Foo struct {
+		StringValue string `validate:"min=5,max=10"`
+		IntValue    int    `validate:"min=5,max=10"`
+	}
+
+	invalidFoo := &Foo{StringValue: "Fo", IntValue: 3}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.StructN(invalidFoo, 1)
+	}
+}
+
 func BenchmarkStructSimpleSuccessParallel(b *testing.B) {
 	validate := New()
 	type Foo struct {
@@ -904,6 +952,71 @@ func BenchmarkStructSimpleSuccessParallel(b *testing.B) {
 	})
 }
 
+func BenchmarkStructSimpleSuccessGoroutines(b *testing.B) {
+	validate := New()
+	type Foo struct {
+		StringValue string `validate:"min=5,max=10"`
+		IntValue    int    `validate:"min=5,max=10"`
+	}
+	validFoo := &Foo{StringValue: "Foobar", IntValue: 7}
+
+	for _, goroutines := range []int{1, 8, 64} {
+		b.Run(strconv.Itoa(goroutines), func(b *testing.B) {
+			runGoroutines(b, goroutines, func() {
+				_ = validate.Struct(validFoo)
+			})
+		})
+	}
+}
+
+// BenchmarkStructCacheGet compares the copy on write atomic.Value read path used
+// by structCache against a sync.Map for the same, already cached, type.
+func BenchmarkStructCacheGet(b *testing.B) {
+	type Foo struct {
+		StringValue string `validate:"min=5,max=10"`
+	}
+
+	validate := New()
+	typ := reflect.TypeOf(Foo{})
+	_ = validate.Struct(Foo{StringValue: "Foobar"})
+
+	cs, _ := validate.structCache.Get(typ)
+	var sm sync.Map
+	sm.Store(typ, cs)
+
+	for _, goroutines := range []int{1, 8, 64} {
+		b.Run("atomic/"+strconv.Itoa(goroutines), func(b *testing.B) {
+			runGoroutines(b, goroutines, func() {
+				_, _ = validate.structCache.Get(typ)
+			})
+		})
+		b.Run("syncmap/"+strconv.Itoa(goroutines), func(b *testing.B) {
+			runGoroutines(b, goroutines, func() {
+				v, _ := sm.Load(typ)
+				_ = v.(*cStruct)
+			})
+		})
+	}
+}
+
+// runGoroutines splits b.N calls of fn evenly across the given number of goroutines.
+func runGoroutines(b *testing.B, goroutines int, fn func()) {
+	var wg sync.WaitGroup
+	per := b.N/goroutines + 1
+
+	b.ResetTimer()
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < per; n++ {
+				fn()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 func BenchmarkStructSimpleFailure(b *testing.B) {
 	validate := New()
 	type Foo struct {
@@ -1097,3 +1210,46 @@ func BenchmarkOneofParallel(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkStructBatch(b *testing.B) {
+	validate := New()
+
+	type Foo struct {
+		StringValue string `validate:"min=5,max=10"`
+		IntValue    int    `validate:"min=5,max=10"`
+	}
+
+	items := make([]interface{}, 1000)
+	for i := range items {
+		items[i] = &Foo{StringValue: "Foobar", IntValue: 7}
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.StructBatch(items, 0)
+	}
+}
+
+// BenchmarkValid reports the allocations of Valid, which allocates nothing when valid; measured here rather than
+// in TestValid as sync.Pool randomly drops items under the race detector.
+func BenchmarkValid(b *testing.B) {
+	validate := New()
+
+	type Inner struct {
+		Code string `validate:"required,len=3"`
+	}
+
+	type Outer struct {
+		Name  string `validate:"required"`
+		Age   int    `validate:"gte=18"`
+		Inner Inner
+	}
+
+	o := &Outer{Name: "joey", Age: 18, Inner: Inner{Code: "abc"}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.Valid(o)
+	}
+}
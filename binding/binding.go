@@ -0,0 +1,223 @@
+// Package binding decodes HTTP request bodies into structs and validates them,
+// keeping the net/http dependency out of the core validator package.
+//
+// JSON bodies are decoded using encoding/json, form-encoded bodies are decoded
+// into exported fields using their `form` tag, or the field name when absent.
+// FormValidate does the same for url.Values, such as a request's query string.
+//
+//	type Login struct {
+//		Username string `json:"username" form:"username" validate:"required"`
+//		Password string `json:"password" form:"password" validate:"required,min=8"`
+//	}
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//		var login Login
+//		if err := binding.BindAndValidate(validate, r, &login); err != nil {
+//			...
+//		}
+//	}
+package binding
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const formTagName = "form"
+
+// ErrUnsupportedContentType is returned when the request's Content-Type can't be decoded.
+var ErrUnsupportedContentType = errors.New("binding: unsupported content type")
+
+// BindAndValidate decodes the request body into dst, which must be a non-nil pointer,
+// according to the request's Content-Type and validates it using v.
+//
+// Bodies of type application/json, or without a Content-Type, are decoded as JSON and
+// bodies of type application/x-www-form-urlencoded or multipart/form-data as a form.
+//
+// It returns any decoding error as is, an InvalidValidationError for bad values passed in
+// and nil or ValidationErrors otherwise.
+func BindAndValidate(v *validator.Validate, r *http.Request, dst interface{}) error {
+
+	ct := r.Header.Get("Content-Type")
+	mediaType := ""
+
+	if len(ct) > 0 {
+		var err error
+		if mediaType, _, err = mime.ParseMediaType(ct); err != nil {
+			return fmt.Errorf("%w: %s", ErrUnsupportedContentType, ct)
+		}
+	}
+
+	switch mediaType {
+	case "", "application/json":
+		if r.Body == nil {
+			return errors.New("binding: missing request body")
+		}
+		if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+			return err
+		}
+
+	case "application/x-www-form-urlencoded", "multipart/form-data":
+		if err := parseForm(r, mediaType); err != nil {
+			return err
+		}
+		if err := decodeForm(r.PostForm, dst); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedContentType, mediaType)
+	}
+
+	return v.StructCtx(r.Context(), dst)
+}
+
+// FormValidate populates the struct pointed to by dst from values, eg. a request's query string, using the
+// fields' `form` tag, or the field name when absent, and validates it using v. Repeated keys populate slices,
+// otherwise the first value is used, and values are converted to the field's type.
+//
+// It returns any conversion error as is, an InvalidValidationError for bad values passed in and nil or
+// ValidationErrors otherwise.
+func FormValidate(v *validator.Validate, values url.Values, dst interface{}) error {
+	if err := decodeForm(values, dst); err != nil {
+		return err
+	}
+	return v.Struct(dst)
+}
+
+func parseForm(r *http.Request, mediaType string) error {
+	if mediaType == "multipart/form-data" {
+		// 32 MB, the same as http.Request.FormValue
+		return r.ParseMultipartForm(32 << 20)
+	}
+	return r.ParseForm()
+}
+
+// decodeForm sets the exported fields of the struct pointed to by dst from the form values.
+func decodeForm(values url.Values, dst interface{}) error {
+	val := reflect.ValueOf(dst)
+
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return fmt.Errorf("binding: form destination must be a non-nil pointer, got %T", dst)
+	}
+
+	val = val.Elem()
+
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("binding: form destination must point to a struct, got %T", dst)
+	}
+
+	return decodeFormStruct(values, val)
+}
+
+func decodeFormStruct(values url.Values, val reflect.Value) error {
+	typ := val.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		fld := typ.Field(i)
+		fv := val.Field(i)
+
+		if fld.Anonymous && fld.Type.Kind() == reflect.Struct {
+			if err := decodeFormStruct(values, fv); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if len(fld.PkgPath) > 0 {
+			continue
+		}
+
+		name := fld.Tag.Get(formTagName)
+		if name == "-" {
+			continue
+		}
+		if len(name) == 0 {
+			name = fld.Name
+		}
+
+		vals, ok := values[name]
+		if !ok || len(vals) == 0 {
+			continue
+		}
+
+		if err := setFormValue(fv, vals); err != nil {
+			return fmt.Errorf("binding: field '%s': %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func setFormValue(fv reflect.Value, vals []string) error {
+	switch fv.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(fv.Type().Elem())
+		if err := setFormValue(elem.Elem(), vals); err != nil {
+			return err
+		}
+		fv.Set(elem)
+		return nil
+
+	case reflect.Slice:
+		s := reflect.MakeSlice(fv.Type(), len(vals), len(vals))
+		for i := 0; i < len(vals); i++ {
+			if err := setFormString(s.Index(i), vals[i]); err != nil {
+				return err
+			}
+		}
+		fv.Set(s)
+		return nil
+
+	default:
+		return setFormString(fv, vals[0])
+	}
+}
+
+func setFormString(fv reflect.Value, s string) error {
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(s)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(f)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+
+	default:
+		return fmt.Errorf("unsupported type %s", fv.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package binding
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/go-playground/validator/v10"
+)
+
+type Embedded struct {
+	Source string `json:"source" form:"source" validate:"omitempty,oneof=web app"`
+}
+
+type login struct {
+	Embedded
+	Username string   `json:"username" form:"username" validate:"required"`
+	Password string   `json:"password" form:"password" validate:"required,min=8"`
+	Age      *int     `json:"age" form:"age" validate:"omitempty,gte=18"`
+	Remember bool     `json:"remember" form:"remember"`
+	Scopes   []string `json:"scopes" form:"scope" validate:"dive,alpha"`
+	Ignored  string   `form:"-"`
+}
+
+func TestBindAndValidateJSON(t *testing.T) {
+	v := validator.New()
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"username":"joeybloggs","password":"secret123","scopes":["read"]}`))
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	var l login
+	err := BindAndValidate(v, r, &l)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, l.Username, "joeybloggs")
+	assert.Equal(t, l.Scopes, []string{"read"})
+
+	// no content type defaults to JSON
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"username":"joeybloggs","password":"short"}`))
+
+	l = login{}
+	err = BindAndValidate(v, r, &l)
+	assert.NotEqual(t, err, nil)
+
+	errs, ok := err.(validator.ValidationErrors)
+	assert.Equal(t, ok, true)
+	assert.Equal(t, len(errs), 1)
+	assert.Equal(t, errs[0].Namespace(), "login.Password")
+	assert.Equal(t, errs[0].Tag(), "min")
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"username":`))
+	r.Header.Set("Content-Type", "application/json")
+
+	err = BindAndValidate(v, r, &l)
+	assert.NotEqual(t, err, nil)
+	_, ok = err.(validator.ValidationErrors)
+	assert.Equal(t, ok, false)
+}
+
+func TestBindAndValidateForm(t *testing.T) {
+	v := validator.New()
+
+	form := url.Values{
+		"username": {"joeybloggs"},
+		"password": {"secret123"},
+		"age":      {"21"},
+		"remember": {"true"},
+		"scope":    {"read", "write"},
+		"source":   {"web"},
+		"Ignored":  {"x"},
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var l login
+	err := BindAndValidate(v, r, &l)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, l.Username, "joeybloggs")
+	assert.Equal(t, *l.Age, 21)
+	assert.Equal(t, l.Remember, true)
+	assert.Equal(t, l.Scopes, []string{"read", "write"})
+	assert.Equal(t, l.Source, "web")
+	assert.Equal(t, l.Ignored, "")
+
+	form.Set("age", "16")
+	form.Set("source", "fax")
+	form.Del("password")
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	l = login{}
+	err = BindAndValidate(v, r, &l)
+	assert.NotEqual(t, err, nil)
+
+	errs := err.(validator.ValidationErrors)
+	assert.Equal(t, len(errs), 3)
+	assert.Equal(t, errs[0].Namespace(), "login.Embedded.Source")
+	assert.Equal(t, errs[1].Namespace(), "login.Password")
+	assert.Equal(t, errs[2].Namespace(), "login.Age")
+
+	form.Set("age", "old")
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	err = BindAndValidate(v, r, &l)
+	assert.NotEqual(t, err, nil)
+	assert.Equal(t, strings.HasPrefix(err.Error(), "binding: field 'age'"), true)
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	err = BindAndValidate(v, r, l)
+	assert.NotEqual(t, err, nil)
+	assert.Equal(t, err.Error(), "binding: form destination must be a non-nil pointer, got binding.login")
+}
+
+func TestBindAndValidateUnsupported(t *testing.T) {
+	v := validator.New()
+
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("<login/>")))
+	r.Header.Set("Content-Type", "application/xml")
+
+	var l login
+	err := BindAndValidate(v, r, &l)
+	assert.NotEqual(t, err, nil)
+	assert.Equal(t, errors.Is(err, ErrUnsupportedContentType), true)
+	assert.Equal(t, err.Error(), "binding: unsupported content type: application/xml")
+}
+
+func TestFormValidate(t *testing.T) {
+	v := validator.New()
+
+	type search struct {
+		Query   string   `form:"q" validate:"required"`
+		Page    uint     `form:"page" validate:"omitempty,gte=1"`
+		Limit   *int     `form:"limit" validate:"omitempty,lte=100"`
+		Exact   bool     `form:"exact"`
+		Score   float64  `form:"score"`
+		Tags    []string `form:"tag" validate:"dive,alpha"`
+		IDs     []int64  `form:"id"`
+		Ignored string   `form:"-"`
+	}
+
+	values, err := url.ParseQuery("q=shoes&page=2&limit=50&exact=1&score=4.5&tag=red&tag=blue&id=3&id=7&Ignored=x")
+	assert.Equal(t, err, nil)
+
+	var s search
+	err = FormValidate(v, values, &s)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, s.Query, "shoes")
+	assert.Equal(t, s.Page, uint(2))
+	assert.Equal(t, *s.Limit, 50)
+	assert.Equal(t, s.Exact, true)
+	assert.Equal(t, s.Score, 4.5)
+	assert.Equal(t, s.Tags, []string{"red", "blue"})
+	assert.Equal(t, s.IDs, []int64{3, 7})
+	assert.Equal(t, s.Ignored, "")
+
+	values, err = url.ParseQuery("limit=500&tag=red&tag=b1ue")
+	assert.Equal(t, err, nil)
+
+	s = search{}
+	err = FormValidate(v, values, &s)
+	assert.NotEqual(t, err, nil)
+
+	errs := err.(validator.ValidationErrors)
+	assert.Equal(t, len(errs), 3)
+	assert.Equal(t, errs[0].Namespace(), "search.Query")
+	assert.Equal(t, errs[1].Namespace(), "search.Limit")
+	assert.Equal(t, errs[2].Namespace(), "search.Tags[1]")
+
+	values, err = url.ParseQuery("q=shoes&id=3&id=x")
+	assert.Equal(t, err, nil)
+
+	err = FormValidate(v, values, &s)
+	assert.NotEqual(t, err, nil)
+	assert.Equal(t, strings.HasPrefix(err.Error(), "binding: field 'id'"), true)
+
+	err = FormValidate(v, values, s)
+	assert.NotEqual(t, err, nil)
+	assert.Equal(t, err.Error(), "binding: form destination must be a non-nil pointer, got binding.search")
+}
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"container/list"
 	"fmt"
 	"reflect"
 	"strings"
@@ -20,6 +21,7 @@ const (
 	typeOr
 	typeKeys
 	typeEndKeys
+	typeSkip
 )
 
 const (
@@ -28,13 +30,30 @@ const (
 	keysTagNotDefined   = "'" + endKeysTag + "' tag encountered without a corresponding '" + keysTag + "' tag"
 )
 
+// structCache is a read-mostly copy on write cache; reads are lock free via the atomic.Value
+// while writers serialize on lock, copy the current map and atomically store the new one so
+// readers never observe a partially built entry.
+//
+// When maxSize is greater than zero the cache is bounded, tracking usage in lru which requires
+// taking lruLock on reads in order to evict the least recently used entry.
 type structCache struct {
-	lock sync.Mutex
-	m    atomic.Value // map[reflect.Type]*cStruct
+	lock    sync.Mutex
+	m       atomic.Value // map[reflect.Type]*cStruct
+	maxSize int
+	lruLock sync.Mutex
+	lru     *list.List // of reflect.Type, most recently used at the front
+	lruIdx  map[reflect.Type]*list.Element
 }
 
 func (sc *structCache) Get(key reflect.Type) (c *cStruct, found bool) {
 	c, found = sc.m.Load().(map[reflect.Type]*cStruct)[key]
+	if found && sc.maxSize > 0 {
+		sc.lruLock.Lock()
+		if e, ok := sc.lruIdx[key]; ok {
+			sc.lru.MoveToFront(e)
+		}
+		sc.lruLock.Unlock()
+	}
 	return
 }
 
@@ -45,9 +64,89 @@ func (sc *structCache) Set(key reflect.Type, value *cStruct) {
 		nm[k] = v
 	}
 	nm[key] = value
+
+	if sc.maxSize > 0 {
+		sc.lruLock.Lock()
+		sc.lruIdx[key] = sc.lru.PushFront(key)
+		for sc.lru.Len() > sc.maxSize {
+			e := sc.lru.Back()
+			sc.lru.Remove(e)
+			delete(sc.lruIdx, e.Value.(reflect.Type))
+			delete(nm, e.Value.(reflect.Type))
+		}
+		sc.lruLock.Unlock()
+	}
+
 	sc.m.Store(nm)
 }
 
+// setMaxSize resets the cache, bounding it to size entries or unbounded when size <= 0.
+func (sc *structCache) setMaxSize(size int) {
+	sc.lock.Lock()
+	sc.maxSize = size
+	sc.lock.Unlock()
+
+	sc.reset()
+}
+
+// reset evicts all entries, keeping the cache's bound.
+func (sc *structCache) reset() {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	sc.lruLock.Lock()
+	sc.lru = list.New()
+	sc.lruIdx = make(map[reflect.Type]*list.Element)
+	sc.lruLock.Unlock()
+
+	sc.m.Store(make(map[reflect.Type]*cStruct))
+}
+
+// patternCache is a set of strings bounded to maxSize entries, evicting the least recently used
+// in the same manner as a bounded structCache.
+type patternCache struct {
+	lock    sync.Mutex
+	maxSize int
+	lru     *list.List // of string, most recently used at the front
+	lruIdx  map[string]*list.Element
+}
+
+func newPatternCache(maxSize int) *patternCache {
+	return &patternCache{
+		maxSize: maxSize,
+		lru:     list.New(),
+		lruIdx:  make(map[string]*list.Element),
+	}
+}
+
+func (pc *patternCache) Contains(key string) bool {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	e, ok := pc.lruIdx[key]
+	if ok {
+		pc.lru.MoveToFront(e)
+	}
+	return ok
+}
+
+func (pc *patternCache) Add(key string) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	if e, ok := pc.lruIdx[key]; ok {
+		pc.lru.MoveToFront(e)
+		return
+	}
+
+	pc.lruIdx[key] = pc.lru.PushFront(key)
+	for pc.lru.Len() > pc.maxSize {
+		e := pc.lru.Back()
+		pc.lru.Remove(e)
+		delete(pc.lruIdx, e.Value.(string))
+	}
+}
+
 type tagCache struct {
 	lock sync.Mutex
 	m    atomic.Value // map[string]*cTag
@@ -69,9 +168,11 @@ func (tc *tagCache) Set(key string, value *cTag) {
 }
 
 type cStruct struct {
-	name   string
-	fields []*cField
-	fn     StructLevelFuncCtx
+	name        string
+	fields      []*cField
+	untagged    []string // names of the exported fields lacking a validation tag
+	fn          StructLevelFuncCtx
+	validatable bool // implements Validatable or ValidatableCtx, itself or as a pointer
 }
 
 type cField struct {
@@ -79,6 +180,8 @@ type cField struct {
 	name       string
 	altName    string
 	namesEqual bool
+	anonymous  bool // an embedded struct field
+	sensitive  bool // has the tag set using SetSensitiveTag, its value redacted by FieldError.ValueString
 	cTags      *cTag
 }
 
@@ -111,7 +214,7 @@ func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStr
 		return cs
 	}
 
-	cs = &cStruct{name: sName, fields: make([]*cField, 0), fn: v.structLevelFuncs[typ]}
+	cs = &cStruct{name: sName, fields: make([]*cField, 0), fn: v.structLevelFuncs[typ], validatable: implementsValidatable(typ)}
 
 	numFields := current.NumField()
 
@@ -120,6 +223,8 @@ func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStr
 	var tag string
 	var customName string
 
+	rules := v.structRules[typ]
+
 	for i := 0; i < numFields; i++ {
 
 		fld = typ.Field(i)
@@ -128,7 +233,34 @@ func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStr
 			continue
 		}
 
-		tag = fld.Tag.Get(v.tagName)
+		tag, ok = fld.Tag.Lookup(v.tagName)
+
+		if tag != skipValidationTag {
+			for _, name := range v.extraTagNames {
+				extra, found := fld.Tag.Lookup(name)
+				if !found || len(extra) == 0 || extra == skipValidationTag {
+					continue
+				}
+				if len(tag) > 0 {
+					tag += tagSeparator + extra
+				} else {
+					tag = extra
+				}
+				ok = true
+			}
+		}
+
+		if rule, found := rules[fld.Name]; found {
+			tag, ok = rule, true
+		}
+
+		if !ok && !fld.Anonymous {
+			tag = v.defaultFieldTag
+
+			if len(tag) == 0 {
+				cs.untagged = append(cs.untagged, fld.Name)
+			}
+		}
 
 		if tag == skipValidationTag {
 			continue
@@ -148,6 +280,12 @@ func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStr
 
 		if len(tag) > 0 {
 			ctag, _ = v.parseFieldTagsRecursive(tag, fld.Name, "", false)
+
+			// dives into types which aren't collections are always configuration errors, the kinds of other
+			// validations only when strict
+			if err := v.checkTagKinds(ctag, fld.Type, v.strictTagKinds); err != nil {
+				panic(fmt.Sprintf("%s on field '%s'", err, fld.Name))
+			}
 		} else {
 			// even if field doesn't have validations need cTag for traversing to potential inner/nested
 			// elements of the field.
@@ -160,12 +298,104 @@ func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStr
 			altName:    customName,
 			cTags:      ctag,
 			namesEqual: fld.Name == customName,
+			anonymous:  fld.Anonymous,
+			sensitive:  len(v.sensitiveTag) > 0 && len(fld.Tag.Get(v.sensitiveTag)) > 0,
 		})
 	}
 	v.structCache.Set(typ, cs)
 	return cs
 }
 
+// collectRules adds the rules of the fields of the struct type typ, prefixed by ns, to rules recursing into nested
+// structs; path holding the types being expanded to guard against recursive types.
+func (v *Validate) collectRules(typ reflect.Type, ns string, path map[reflect.Type]struct{}, rules map[string][]Rule) {
+	if _, ok := path[typ]; ok {
+		return
+	}
+	path[typ] = struct{}{}
+	defer delete(path, typ)
+
+	cs, ok := v.structCache.Get(typ)
+	if !ok {
+		cs = v.extractStructCache(reflect.New(typ).Elem(), structName(typ))
+	}
+
+	for _, f := range cs.fields {
+		name := ns + f.altName
+
+		chain := flattenRules(f.cTags, nil)
+		if len(chain) > 0 {
+			rules[name] = chain
+		}
+
+		// follow the field's type through each dive to find any struct validated
+		ft := typ.Field(f.idx).Type
+		inKeys := false
+		for _, r := range chain {
+			switch r.Tag {
+			case keysTag:
+				inKeys = true
+			case endKeysTag:
+				inKeys = false
+			}
+			if inKeys || r.Tag != diveTag {
+				continue
+			}
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			switch ft.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				ft = ft.Elem()
+				name += v.nsLeftBracket + v.nsRightBracket
+			}
+		}
+
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		if ft.Kind() != reflect.Struct || ft == timeType || v.isValueStruct(ft) {
+			continue
+		}
+
+		if f.anonymous && v.flattenEmbedded {
+			v.collectRules(ft, ns, path, rules)
+			continue
+		}
+
+		v.collectRules(ft, name+v.nsSeparator, path, rules)
+	}
+}
+
+// flattenRules appends the rules of the tag chain ct to rules.
+func flattenRules(ct *cTag, rules []Rule) []Rule {
+	for ; ct != nil; ct = ct.next {
+		switch ct.typeof {
+		case typeOmitEmpty:
+			rules = append(rules, Rule{Tag: omitempty})
+		case typeStructOnly:
+			rules = append(rules, Rule{Tag: structOnlyTag})
+		case typeNoStructLevel:
+			rules = append(rules, Rule{Tag: noStructLevelTag})
+		case typeDive:
+			rules = append(rules, Rule{Tag: diveTag})
+		case typeKeys:
+			rules = append(rules, Rule{Tag: keysTag})
+			rules = flattenRules(ct.keys, rules)
+			rules = append(rules, Rule{Tag: endKeysTag})
+		case typeEndKeys:
+			// the end of a keys chain, added by typeKeys
+		default:
+			if !ct.hasTag {
+				continue
+			}
+			rules = append(rules, Rule{Tag: ct.tag, Param: ct.param, Or: ct.typeof == typeOr && !ct.isBlockEnd})
+		}
+	}
+	return rules
+}
+
 func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias string, hasAlias bool) (firstCtag *cTag, current *cTag) {
 	var t string
 	noAlias := len(alias) == 0
@@ -259,8 +489,17 @@ func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias s
 			if t == isdefault {
 				current.typeof = typeIsDefault
 			}
-			// if a pipe character is needed within the param you must use the utf8Pipe representation "0x7C"
-			orVals := strings.Split(t, orSeparator)
+			// if a pipe character is needed within the param you must use the utf8Pipe representation "0x7C",
+			// except for validations whose param is a '|' separated list
+			name := t
+			if idx := strings.Index(t, tagKeySeparator); idx != -1 {
+				name = t[:idx]
+			}
+
+			orVals := []string{t}
+			if _, ok := pipeParamTags[name]; !ok {
+				orVals = strings.Split(t, orSeparator)
+			}
 
 			for j := 0; j < len(orVals); j++ {
 				vals := strings.SplitN(orVals[j], tagKeySeparator, 2)
@@ -291,6 +530,8 @@ func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias s
 
 				if len(orVals) > 1 {
 					current.typeof = typeOr
+				} else if current.tag == skipIfTag || current.tag == skipUnlessTag {
+					current.typeof = typeSkip
 				}
 
 				if len(vals) > 1 {
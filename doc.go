@@ -25,6 +25,40 @@ ValidationErrors as type error; so, in your code all you need to do is check
 if the error returned is not nil, and if it's not check if error is
 InvalidValidationError ( if necessary, most of the time it isn't ) type cast
 it to type ValidationErrors like so err.(validator.ValidationErrors).
+IsInvalidValidationError(err) reports whether the input was bad, its Type and
+Kind fields describing what was received eg. a scalar which should be validated
+using Var rather than Struct.
+
+Options
+
+New accepts options configuring the instance as it's created, rather than
+mutating it afterwards which isn't safe once it's shared between goroutines:
+
+	validate := validator.New(
+		validator.WithTagName("binding"),
+		validator.WithJSONTagNames(),
+		validator.WithRequiredStructEnabled(),
+		validator.WithNowFunc(clock.Now),
+	)
+
+WithTagName and WithNowFunc do as SetTagName and SetNowFunc, WithJSONTagNames
+names fields in errors by their json tag and WithRequiredStructEnabled runs the
+required validations, required and the required_* tags, leading the tags of
+non-pointer struct fields against the struct value itself before validating its
+fields; any other first tag is ignored as without the option.
+
+Multiple Tag Names
+
+AddTagName merges the rules of additional tags with those of the primary tag,
+eg. while migrating from a legacy tag. The chains are concatenated, primary
+first, so only a primary tag of '-' skips the field and omitempty must lead the
+first non-empty tag to take effect:
+
+	validate.AddTagName("binding")
+
+	type User struct {
+		Name string `validate:"required" binding:"max=64"` // required,max=64
+	}
 
 Custom Validation Functions
 
@@ -44,6 +78,63 @@ Custom Validation functions can be added. Example:
 	// NOTES: using the same tag name as an existing function
 	//        will overwrite the existing one
 
+A validation may report why it failed using ReportErrorTag, the tag given being
+recorded in the FieldError, and used to translate it, in place of its own:
+
+	validate.RegisterValidation("username", func(fl validator.FieldLevel) bool {
+		if len(fl.Field().String()) > 8 {
+			fl.ReportErrorTag("too_long")
+			return false
+		}
+		return true
+	})
+
+Request scoped data, such as a tenant ID, may be passed to validations in the
+context.Context given to StructCtx, VarCtx and the like, WithValue adding it;
+validations retrieve it using Context().Value or ContextValue:
+
+	type tenantKey struct{}
+
+	validate.RegisterValidation("sku", func(fl validator.FieldLevel) bool {
+		if fl.ContextValue(tenantKey{}) == "acme" {
+			return strings.HasPrefix(fl.Field().String(), "ACME-")
+		}
+		return fl.Field().Len() > 0
+	})
+
+	ctx := validate.WithValue(r.Context(), tenantKey{}, tenantID)
+	err := validate.StructCtx(ctx, order)
+
+Validations and aliases may be removed again using DeregisterValidation and
+DeregisterAlias, after which the tag is undefined; baked in ones are only
+removed by ForceDeregisterValidation and ForceDeregisterAlias.
+
+	validate.DeregisterValidation("custom tag name")
+
+Self Validating Structs
+
+Structs implementing Validatable, or ValidatableCtx to receive the
+context.Context passed to StructCtx, validate themselves at the struct level
+after their fields, and after any struct level validation registered for the
+type. Methods with pointer receivers are only called for addressable structs,
+eg. when a pointer is validated.
+
+	func (r *Request) Validate(ctx context.Context, sl validator.StructLevel) {
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(r.Wait).After(deadline) {
+			sl.ReportError(r.Wait, "Wait", "Wait", "within_deadline", "")
+		}
+	}
+
+Validating Changes
+
+StructChanged validates only the fields of an updated struct differing, per
+reflect.DeepEqual, from an original of the same type, eg. the stored record a
+partial update applies to. Nested structs are compared field by field, so only
+their changed fields are validated; any other changed field, such as a slice
+or map, is validated in full including the elements dived into.
+
+	err := validate.StructChanged(updated, stored)
+
 Cross-Field Validation
 
 Cross-Field Validation can be done via the following tags:
@@ -98,6 +189,63 @@ used "eqcsfield" it could be multiple levels down. Example:
 	//       whatever you pass, struct, field...
 	//       when calling validate.Field(field, tag) val will be nil
 
+Cross-Field references may also index into slices, arrays and maps using
+brackets, the key being parsed into the map's key type. A missing key or out of
+range index fails the validation, whereas a malformed index or key panics.
+
+	type Test struct {
+		Limits map[string]int
+		Steps  []int
+		Max    int `validate:"eqfield=Limits[max]"`
+		First  int `validate:"eqfield=Steps[0]"`
+	}
+
+The numeric comparisons of eqfield, nefield, gtfield, gtefield, ltfield and
+ltefield also apply between differing numeric kinds, eg. an int field compared
+to an uint16 or float32 one. Integers are compared exactly, a negative signed
+value always being less than an unsigned one, and are converted to float64 only
+when compared against a float.
+
+	type Test struct {
+		MinAge uint8
+		Age    int `validate:"gtefield=MinAge"`
+	}
+
+A reference naming a field which doesn't exist, eg. a typo such as
+eqfield=Pasword, isn't resolved and the validation behaves as though the field
+couldn't be found. Using SetStrictCrossField(true) such references are instead
+reported as an error with the tag '_badcrossfield' and the reference as its
+param, whichever validation holds it, including skip_if and or'd validations;
+where another or'd validation passes the field is still valid.
+
+Introspecting Rules
+
+StructRules returns the validation rules of a struct as data, keyed by the
+namespace of each field, without validating; eg. to generate client side
+validations. Dive, keys and endkeys are rules of their own, the rules following
+them applying to the elements or keys, and the fields of struct elements are
+namespaced using '[]' in place of an index or key. Cross-field params name the
+field relative to the struct holding it.
+
+	rules := validate.StructRules(User{})
+	// rules["User.Addresses[].Street"] == []validator.Rule{{Tag: "required"}}
+
+Coercing Numeric Strings
+
+StructCoerce validates as Struct does, after first parsing string fields tagged
+coerce=Target into their numeric sibling field, or pointer to one, named Target;
+so that the target's own validations run against the parsed number. The struct
+must be passed by pointer as it's modified. A string which doesn't parse, or is
+empty, leaves the target untouched for the string's own validations to report.
+Outside of StructCoerce the coerce tag always passes.
+
+	type Query struct {
+		Limit    string `validate:"numeric,coerce=LimitNum"`
+		LimitNum int    `validate:"min=1,max=100"`
+	}
+
+	err := validate.StructCoerce(&q)
+
 Multiple Validators
 
 Multiple validators on a field will process in the order defined. Example:
@@ -108,6 +256,39 @@ Multiple validators on a field will process in the order defined. Example:
 
 	// max will be checked then min
 
+Errors are returned in a deterministic, depth-first, declaration order; each field's
+errors are reported before those of the next field, with the fields of a nested
+struct reported in place of the struct field itself, followed by any struct level
+validation errors of that struct. Elements of slices and arrays are reported in
+index order and map entries in Go's random map iteration order, or in sorted key
+order using the WithSortedMapKeys option.
+
+Valid reports only whether a struct is valid, stopping at the first error and
+allocating nothing when valid, for hot paths which don't need the errors.
+
+SetOmitTopNamespace(true) omits the top level struct name from the namespaces
+of errors, eg. Email rather than User.Email, for clients which already know
+the type; any index added by StructAll, StructMap and the like is kept.
+
+SetMaxErrors caps the number of errors collected, bounding the work done and
+size of the errors for adversarial input. Validation stops once the cap is
+exceeded, returning the first errors followed by one with the tag '_truncated'.
+StructN instead limits a single validation, eg. to display only the first few
+errors, stopping once n are collected and returning just those, in field order.
+
+SetTypeMaxDepth similarly bounds recursive types, such as trees, reporting an
+error with the tag '_maxdepth' for a field nesting the type deeper than allowed
+rather than validating it.
+
+ValidateTagForType checks a tag against a type up front, returning a
+TagKindError when a built-in validation is applied to a kind it doesn't support,
+eg. email on an int or min on a bool, which otherwise pass, fail or panic only
+once reached. SetStrictTagKinds runs the same check for every struct field when
+its struct is first validated, panicking on a mismatch.
+
+	err := validate.ValidateTagForType("required,email", reflect.TypeOf(0))
+	// validator: tag 'email' doesn't support int of kind int
+
 Bad Validator definitions are not handled by the library. Example:
 
 	type Test struct {
@@ -142,11 +323,28 @@ so the above will become excludesall=0x7C
 		Field `validate:"excludesall=0x7C"` // GOOD! Use the UTF-8 hex representation.
 	}
 
+Validations accepting multiple parameters, such as oneof or a custom
+between=3 10, separate them with spaces and custom validations can access
+them individually using FieldLevel.Params(); FieldLevel.Param() still
+returns the raw string. A parameter containing spaces can be wrapped in
+single quotes, the quotes themselves are stripped. Commas and pipes within
+parameters must still be escaped using 0x2C and 0x7C.
+
+	type Test struct {
+		Field string `validate:"oneof='red green' blue"` // 'red green' or 'blue'
+	}
+
 
 Baked In Validators and Tags
 
 Here is a list of the current built in validators:
 
+A []byte field is validated as its string contents by the string validators,
+eg. email, alpha or contains, whereas those also applying to slices, such as
+len, min, max, eq, gt, lt, unique, notblank and the required family, validate it
+as a slice; len=10 on a []byte therefore counts bytes while on a string it
+counts characters (runes).
+
 
 Skip Field
 
@@ -180,6 +378,34 @@ Same as structonly tag except that any struct level validations will not run.
 
 	Usage: nostructlevel
 
+Embedded Structs
+
+The fields of embedded, anonymous, structs are validated using the embedded
+type's own tags, whether embedded by value or pointer, and struct level
+validations registered for the embedded type run too; a nil embedded pointer
+is skipped. Promoted fields aren't flattened, their errors are namespaced by
+the embedded type's name eg. User.BaseModel.ID rather than User.ID, unless
+SetFlattenEmbedded(true) is used. The skip tag '-' on the embedded field opts
+out as for any other field.
+
+Note the value of an embedded unexported type can't be obtained using
+Interface() within a struct level validation, as reflect considers it
+unexported, use exported types when registering struct level validations.
+
+Opaque Types
+
+Struct types registered using RegisterOpaqueType are validated as values, as
+time.Time is, and never recursed into; only the validation tags of the fields
+holding them are run against them. Only time.Time is validated as a value by
+default; the WithStdlibOpaqueTypes option registers other common standard
+library types such as url.URL, big.Int and regexp.Regexp.
+
+	validate.RegisterOpaqueType(decimal.Decimal{})
+
+	type Order struct {
+		Total decimal.Decimal `validate:"required"`
+	}
+
 Omit Empty
 
 Allows conditional validation, for example if a field is not set with
@@ -188,6 +414,24 @@ such as min or max won't run, but if a value is set validation will run.
 
 	Usage: omitempty
 
+Pointers
+
+Non-nil pointers, to any depth, are dereferenced before being validated so
+tags such as min, max, gt, lt and len compare the value pointed to, eg. the int
+of an *int or the length of the string of a *string. A nil pointer fails any
+such validation, failing closed, rather than being skipped; combine with
+omitempty to skip nil pointers, whereas required rejects them.
+
+	type Test struct {
+		Age  *int    `validate:"omitempty,min=18"` // nil skipped, otherwise *Age >= 18
+		Name *string `validate:"required,max=10"`  // nil rejected
+	}
+
+A non-nil pointer has a value even when pointing at a zero value, eg. "".
+SetEmptyStringAsNil(true) treats a pointer to an empty string as nil for
+required, omitempty and the like instead, as APIs commonly send "" to mean
+unset; the value itself isn't modified.
+
 Dive
 
 This tells the validator to dive into a slice, array or map and validate that
@@ -198,6 +442,13 @@ the Keys & EndKeys section just below.
 
 	Usage: dive
 
+Applying dive to a field which isn't a slice, array, map or registered iterator,
+eg. an int, is a configuration error; it panics when the struct is first
+validated, naming the field, and ValidateTagForType reports it at startup:
+
+	err := validate.ValidateTagForType("dive,gt=0", reflect.TypeOf(0))
+	// validator: dive used on non-collection type int of kind int
+
 Example #1
 
 	[][]string with validation tag "gt=0,dive,len=1,dive,required"
@@ -212,6 +463,57 @@ Example #2
 	// []string will be spared validation
 	// required will be applied to string
 
+Struct elements, including pointers to structs such as map[string]*Config, are
+validated fully, their fields and struct level validations, and namespaced by
+index or key eg. Configs[primary].Port. Nil pointer elements are skipped unless
+a tag following dive, such as required, reports them.
+
+Cross-field validations used after dive, such as ltefield, resolve their
+field against the struct containing the slice, array or map and not the
+element itself, allowing each element to be compared against a sibling field.
+
+Example #3
+
+	type Test struct {
+		Cap  int
+		Vals []int `validate:"dive,ltefield=Cap"`
+	}
+	// ltefield=Cap will compare each element of Vals against Test.Cap
+
+Elements of interface type, eg. of a []interface{} decoded from JSON, are
+validated according to their dynamic type; structs, and pointers to structs,
+are validated recursively, including their struct level validations, and nil
+elements are skipped unless a tag following dive, such as required, rejects them.
+
+Custom collection types, such as a linked list, may be dived into by
+registering an IteratorFunc yielding their elements using RegisterIterator;
+the elements being namespaced by a running index as for slices.
+
+	validate.RegisterIterator(List{}, func(list reflect.Value) func() (reflect.Value, bool) {
+		n := list.Interface().(List).Head
+		return func() (reflect.Value, bool) {
+			if n == nil {
+				return reflect.Value{}, false
+			}
+			v := n.Value
+			n = n.Next
+			return reflect.ValueOf(v), true
+		}
+	})
+
+When diving into a map whose keys are structs, or pointers to structs, the
+fields of each key are validated, as are those of struct values. Errors of a
+key's fields are namespaced with a 'key:' prefix within the brackets to tell
+them apart from those of the value.
+
+Example #4
+
+	type Test struct {
+		Items map[Key]Value `validate:"dive"`
+	}
+	// an error of Key.ID is namespaced Test.Items[key:{0 b}].ID
+	// an error of Value.Count is namespaced Test.Items[{0 b}].Count
+
 Keys & EndKeys
 
 These are to be used together directly after the dive tag and tells the validator
@@ -236,15 +538,54 @@ Example #2
 	// eg=1|eq=2 will be applied to each array element in the the map keys
 	// required will be applied to map values
 
+Example #3
+
+Standalone maps are validated the same way using Var, with errors namespaced
+by the key alone eg. [bb], in sorted key order.
+
+	err := validate.Var(counts, "gt=0,dive,keys,min=2,endkeys,required")
+
 Required
 
 This validates that the value is not the data types default zero value.
 For numbers ensures value is not zero. For strings ensures value is
 not "". For slices, maps, pointers, interfaces, channels and functions
-ensures the value is not nil.
+ensures the value is not nil; an empty but non-nil slice, map or channel
+passes, use gt=0 in addition when elements are also required.
+Non-pointer structs are only checked when using WithRequiredStructEnabled,
+failing when all of their fields are zero valued.
 
 	Usage: required
 
+Types whose logical zero isn't their zero value, eg. a decimal whose 0.00 holds
+a non-zero scale, may register a ZeroFunc using RegisterZeroFunc which is used
+by required, omitempty and the like in its place. Registered struct types are
+validated as values rather than being descended into.
+
+	validate.RegisterZeroFunc(decimal.Decimal{}, func(field reflect.Value) bool {
+		return field.Interface().(decimal.Decimal).IsZero()
+	})
+
+Defaults
+
+A func setting the default value of fields of a type may be registered using
+RegisterDefaulter; it's called with each field of the type holding the zero
+value before it's validated, so its validation tags see the default. This
+mutates the value being validated, which must be passed by pointer; values
+which can't be set are validated as is.
+
+	type Plan string
+
+	validate.RegisterDefaulter(Plan(""), func(field reflect.Value) {
+		field.SetString("free")
+	})
+
+	type Account struct {
+		Plan Plan `validate:"oneof=free pro"`
+	}
+
+	err := validate.Struct(&account) // an empty Plan becomes free
+
 Required If
 
 The field under validation must be present and not empty only if all
@@ -262,6 +603,15 @@ Examples:
 	// require the field if the Field1 and Field2 is equal to the value respectively:
 	Usage: required_if=Field1 foo Field2 bar
 
+Required If Any
+
+The field under validation must be present and not empty if any of the other
+specified fields are equal to the value following the specified field, the OR
+counterpart of required_if. Fields which can't be found, eg. through a nil
+pointer, don't match.
+
+	Usage: required_if_any=Type premium Region EU
+
 Required Unless
 
 The field under validation must be present and not empty unless all
@@ -341,6 +691,117 @@ Example:
 	// require the field if the Field1 and Field2 is not present:
 	Usage: required_without_all=Field1 Field2
 
+Required One Of
+
+At least one of the specified fields, resolved within the struct containing
+the field under validation, must be present and not empty; eg. a contact form
+requiring an email or a phone number. The field under validation is only
+considered when listed, the error being reported against it.
+
+	Usage: required_one_of
+
+Example:
+
+	// require Email or Phone, or both:
+	Email string `validate:"required_one_of=Email Phone"`
+
+Required Exactly One Of
+
+Exactly one of the specified fields, resolved as for required_one_of, must be
+present and not empty; failing when none or several of them are.
+
+	Usage: required_exactly_one_of
+
+Example:
+
+	// require either Email or Phone, not both:
+	Email string `validate:"required_exactly_one_of=Email Phone"`
+
+Skip If
+
+All remaining validations of the field, including format checks, are skipped
+if all the other specified fields are equal to the value following the
+specified field, unlike required_if which only affects presence. It must be
+the first tag on the field for it to skip all the others.
+
+	Usage: skip_if
+
+Examples:
+
+	// skip validating the field if the Enabled field is false:
+	Usage: skip_if=Enabled false,required,url
+
+	// skip validating the field if Kind is equal to internal and Version equal to 1:
+	Usage: skip_if=Kind internal Version 1,required,email
+
+Skip Unless
+
+All remaining validations of the field are skipped unless all the other
+specified fields are equal to the value following the specified field.
+
+	Usage: skip_unless
+
+Example:
+
+	// only validate the field if the Enabled field is true:
+	Usage: skip_unless=Enabled true,required,url
+
+Excluded With
+
+The field under validation must not be present or is empty if any of
+the other specified fields are present. For strings ensures value is
+"". For slices, maps, pointers, interfaces, channels and functions
+ensures the value is nil.
+
+	Usage: excluded_with
+
+Examples:
+
+	// exclude the field if the Password field is present:
+	Usage: excluded_with=Password
+
+	// exclude the field if the Field1 or Field2 is present:
+	Usage: excluded_with=Field1 Field2
+
+Excluded With All
+
+The field under validation must not be present or is empty if all
+of the other specified fields are present.
+
+	Usage: excluded_with_all
+
+Example:
+
+	// exclude the field if the Field1 and Field2 is present:
+	Usage: excluded_with_all=Field1 Field2
+
+Excluded Without
+
+The field under validation must not be present or is empty when any
+of the other specified fields are not present.
+
+	Usage: excluded_without
+
+Examples:
+
+	// exclude the field if the Password field is not present:
+	Usage: excluded_without=Password
+
+	// exclude the field if the Field1 or Field2 is not present:
+	Usage: excluded_without=Field1 Field2
+
+Excluded Without All
+
+The field under validation must not be present or is empty when all
+of the other specified fields are not present.
+
+	Usage: excluded_without_all
+
+Example:
+
+	// exclude the field if the Field1 and Field2 is not present:
+	Usage: excluded_without_all=Field1 Field2
+
 Is Default
 
 This validates that the value is the default value and is almost the
@@ -348,12 +809,32 @@ opposite of required.
 
 	Usage: isdefault
 
+When combined with omitempty, isdefault never fails since an empty
+value skips the remaining validations and any other value is not the
+default; it is therefore most useful on its own or inside an 'or' group.
+
+Not Blank
+
+This validates that the value is not blank. For strings ensures the value
+is not "" and does not consist solely of whitespace, as determined by
+strings.TrimSpace, unlike required which accepts " ". For slices, arrays,
+maps and channels ensures the length is not zero. For pointers, interfaces
+and functions ensures the value is not nil. Other types behave like required.
+
+	Usage: notblank
+
+Combined with omitempty an empty string "" is skipped, but a whitespace
+only string such as " " is still validated and fails.
+
+	Usage: omitempty,notblank
+
 Length
 
 For numbers, length will ensure that the value is
 equal to the parameter given. For strings, it checks that
 the string length is exactly that number of characters. For slices,
-arrays, and maps, validates the number of items.
+arrays, and maps, validates the number of items; for []byte this is the
+number of bytes.
 
 Example #1
 
@@ -384,6 +865,20 @@ duration given in the parameter.
 
 	Usage: max=1h30m
 
+Stringer Lengths
+
+After RegisterStringerLength, len, min and max validate the length of the
+String() output of fmt.Stringer values without a length of their own, such as
+enums, rather than their numeric value; strings, slices, arrays, maps,
+time.Time and time.Duration are unaffected. Struct types must also be
+registered using RegisterOpaqueType for the tags to run against them.
+
+	validate.RegisterStringerLength()
+
+	type Account struct {
+		Status Status `validate:"max=10"` // len(Status.String()) <= 10
+	}
+
 Minimum
 
 For numbers, min will ensure that the value is
@@ -404,9 +899,11 @@ the duration given in the parameter.
 
 Equals
 
-For strings & numbers, eq will ensure that the value is
-equal to the parameter given. For slices, arrays, and maps,
-validates the number of items.
+For strings, numbers & bools, eq will ensure that the value is
+equal to the parameter given, parsed as the field's kind; so eq=5
+compares an int numerically, eq=active a string as is and eq=true
+a bool, panicking when the parameter can't be parsed. For slices,
+arrays, and maps, validates the number of items.
 
 Example #1
 
@@ -421,9 +918,9 @@ in the parameter.
 
 Not Equal
 
-For strings & numbers, ne will ensure that the value is not
-equal to the parameter given. For slices, arrays, and maps,
-validates the number of items.
+For strings, numbers & bools, ne will ensure that the value is not
+equal to the parameter given, parsed as the field's kind as for eq.
+For slices, arrays, and maps, validates the number of items.
 
 Example #1
 
@@ -448,6 +945,36 @@ the target string between single quotes.
            oneof='red green' 'blue yellow'
            oneof=5 7 9
 
+In Set
+
+For strings, ints, and uints, inset will ensure that the value is a member of
+the set of the name given by the parameter, registered using RegisterDynamicSet,
+as returned by its provider at the time of validation; allowing the allowed
+values to change at runtime. Ints and uints are matched by their decimal string
+representation. The provider must be safe for concurrent use.
+
+	Usage: inset=plans
+
+Rune In
+
+For single character strings, runes and bytes, rune_in will ensure that the
+character falls within one of the inclusive ranges, or is one of the characters,
+given by the parameter; a '-' between two characters denotes a range and is
+otherwise the character itself. Strings of any other length fail. Commas and
+pipes in the parameter must be written as 0x2C and 0x7C respectively.
+
+	Usage: rune_in=A-Z0-9_
+
+One Of Case Insensitive
+
+For strings, oneofci will ensure that the value is one of the values in
+the parameter ignoring case, folding both using strings.EqualFold, so RED,
+Red and red all match oneofci=red green. The parameter is formatted as for
+oneof, whereas oneof itself remains case sensitive.
+
+    Usage: oneofci=red green
+           oneofci='Dark Red' 'Light Blue'
+
 Greater Than
 
 For numbers, this will ensure that the value is greater than the
@@ -537,6 +1064,51 @@ duration given in the parameter.
 
 	Usage: lte=1h30m
 
+Future and Past
+
+For time.Time ensures the time value is after, for future, or before, for past,
+the current time at validation, as returned by the clock set using SetNowFunc
+which defaults to time.Now. The optional parameter is a duration tolerating
+clock skew, eg. future=5m also accepts times up to 5 minutes in the past. The zero
+time.Time is accepted, combine with required to reject it. gtnow and ltnow are
+equivalent to future and past respectively.
+
+	Usage: future
+	Usage: future=5m
+	Usage: required,past
+	Usage: gtnow
+	Usage: ltnow=1s
+
+Minimum and Maximum Age
+
+For time.Time birthdates ensures the age, in whole years at the current time
+as returned by the clock set using SetNowFunc, is at least, for agemin, or at
+most, for agemax, the parameter. A year of age is complete once its month and
+day are reached in the birthdate's location, so those born on the 29th of
+February come of age on the 1st of March in non leap years. The zero time.Time
+is accepted, combine with required to reject it.
+
+	Usage: agemin=18
+	Usage: required,agemin=18,agemax=120
+
+Length Equals Another Field
+
+This validates that the length of the value equals the length of another field,
+eg. two parallel slices. Strings, measured in runes, slices, arrays, maps and
+channels may be compared with one another.
+
+	Usage: lenfield=Ages
+
+Length Less Than, Less Than or Equal, Greater Than, Greater Than or Equal Another Field
+
+These validate the length of the value against the length of another field the
+same way as lenfield.
+
+	Usage: ltlenfield=Ages
+	Usage: ltelenfield=Ages
+	Usage: gtlenfield=Ages
+	Usage: gtelenfield=Ages
+
 Field Equals Another Field
 
 This will validate the field value against another fields value either within
@@ -608,7 +1180,9 @@ Field Greater Than or Equal To Another Field
 
 Only valid for Numbers, time.Duration and time.Time types, this will validate
 the field value against another fields value either within a struct or passed in
-field. usage examples are for validation of a Start and End date:
+field. time.Time values, or pointers to them, are compared as instants using
+After and Equal, so equal timestamps pass regardless of location or monotonic
+clock reading. usage examples are for validation of a Start and End date:
 
 Example #1:
 
@@ -679,7 +1253,17 @@ This does the same as contains except for struct fields. It should only be used
 with string types. See the behavior of reflect.Value.String() for behavior on
 other types.
 
-	Usage: containsfield=InnerStructField.Field
+	Usage: fieldcontains=InnerStructField.Field
+
+Contains Field
+
+This validates that the field contains the value of another field. For strings the
+other field's value must be a substring, for slices and arrays it must equal one of
+the elements; numbers are compared by value across kinds. Validation fails when the
+other field can't be found, which is reported as _badcrossfield when
+SetStrictCrossField is enabled.
+
+	Usage: containsfield=RequiredTag
 
 Field Excludes Another Field
 
@@ -687,7 +1271,7 @@ This does the same as excludes except for struct fields. It should only be used
 with string types. See the behavior of reflect.Value.String() for behavior on
 other types.
 
-	Usage: excludesfield=InnerStructField.Field
+	Usage: fieldexcludes=InnerStructField.Field
 
 Unique
 
@@ -702,6 +1286,28 @@ in a field of the struct specified via a parameter.
 	// For slices of struct:
 	Usage: unique=field
 
+The uniqueness of tuples across parallel slices, eg. composite keys held in a
+Keys and a Regions slice, can be checked within a struct level validation
+using CheckUnique, which reports a unique error against the first field:
+
+	validate.RegisterStructValidation(func(sl validator.StructLevel) {
+		validator.CheckUnique(sl, "Keys", "Regions")
+	}, Catalog{})
+
+Method
+
+This validates that the zero argument method named by the param, which must
+return a bool, returns true; allowing computed invariants to be reported as
+errors of the field. The method is looked up on the field's value first and,
+when not found there, on the struct containing the field. Methods with either
+a value or pointer receiver are found. It will panic when the method can't be
+found or doesn't have the signature func() bool. As with other validations
+the tag isn't run on struct fields, whose own fields are validated instead.
+
+	// on the struct containing the field
+	// func (o Order) TotalValid() bool
+	Usage: method=TotalValid
+
 Alpha Only
 
 This validates that a string value contains ASCII alpha characters only
@@ -726,6 +1332,35 @@ This validates that a string value contains unicode alphanumeric characters only
 
 	Usage: alphanumunicode
 
+Alpha Space
+
+This validates that a string value contains ASCII alpha characters and spaces only.
+Only the space character U+0020 is accepted; tabs, newlines and other whitespace
+fail. An empty string passes, so combine with required to reject it.
+
+	Usage: alphaspace
+
+Alphanumeric Space
+
+This validates that a string value contains ASCII alphanumeric characters and
+spaces only, with the same space and empty string rules as alphaspace.
+
+	Usage: alphanumspace
+
+Alpha Space Unicode
+
+This validates that a string value contains unicode alpha characters and spaces
+only, with the same space and empty string rules as alphaspace.
+
+	Usage: alphaspaceunicode
+
+Alphanumeric Space Unicode
+
+This validates that a string value contains unicode alphanumeric characters and
+spaces only, with the same space and empty string rules as alphaspace.
+
+	Usage: alphanumspaceunicode
+
 Number
 
 This validates that a string value contains number values only.
@@ -741,6 +1376,34 @@ for integers or float it returns true.
 
 	Usage: numeric
 
+NOTE: number and numeric validate the text of strings, passing any value of a
+numeric kind, while integer and decimal below validate the kind of the value
+itself and fail for strings; "42" passes numeric but fails integer.
+
+Integer
+
+This validates that the value is of an integer kind, signed or unsigned, failing
+for any other kind including strings holding an integer.
+
+	Usage: integer
+
+Decimal
+
+This validates that the value is of a float kind, failing for any other kind
+including integers and strings holding a decimal.
+
+	Usage: decimal
+
+Digits
+
+For integers, or strings holding only digits after an optional sign, ensures
+the number of decimal digits, ignoring the sign, is exactly the parameter for
+digits or within the inclusive range given by the parameter's two values for
+digits_between. Leading zeros of strings are counted, eg. "007" has 3 digits.
+
+	Usage: digits=9
+	Usage: digits_between=3 5
+
 Hexadecimal String
 
 This validates that a string value contains a valid hexadecimal.
@@ -807,7 +1470,9 @@ does any email provider accept all possibilities.
 
 JSON String
 
-This validates that a string value is valid JSON
+This validates that a string value is valid JSON. A []byte value, including
+json.RawMessage, is validated as the JSON it holds; being a byte slice the
+length validations, such as min and max, apply to its length in bytes.
 
 	Usage: json
 
@@ -819,6 +1484,39 @@ This is done using os.Stat, which is a platform independent function.
 
 	Usage: file
 
+File Path Syntax
+
+This validates that a string value is syntactically a valid file path for the
+operating system, without touching the filesystem, so the path need not exist.
+The path must be non-empty valid UTF-8 and not contain NUL bytes, the only
+characters Unix forbids, where \ is an ordinary character rather than a
+separator as it is on Windows. On Windows, following an optional volume name
+such as C: or \\host\share, the path must also not contain control characters
+nor any of < > : " | ? *.
+
+	Usage: filepath
+
+Password
+
+This validates that a string value satisfies a password policy, given as a
+list of requirements of the form class:count separated by semicolons, as commas
+separate the validations of the tag. The classes are min, the length in
+characters, upper, lower, digit and special, punctuation and symbols, each
+requiring at least count characters. Without a param the policy is
+min:8;upper:1;lower:1;digit:1. On failure the FieldError's Param() is the first
+requirement which wasn't met, eg. upper:1, rather than the whole policy.
+
+	Usage: password
+	Usage: password=min:12;upper:1;lower:1;digit:1;special:1
+
+URI Path
+
+This validates that a string value is a valid URI path, as defined by RFC 3986,
+of segments separated by / made up of unreserved characters, sub-delims, : and @
+or percent-encoded octets. A query or fragment isn't allowed. No IO is done.
+
+	Usage: uri_path
+
 URL String
 
 This validates that a string value contains a valid url
@@ -864,7 +1562,8 @@ Bitcoin Address
 
 This validates that a string value contains a valid bitcoin address.
 The format of the string is checked to ensure it matches one of the three formats
-P2PKH, P2SH and performs checksum validation.
+P2PKH, P2SH and performs checksum validation. Bech32 (segwit) addresses are
+validated by btc_addr_bech32, to accept either use btc_addr|btc_addr_bech32.
 
 	Usage: btc_addr
 
@@ -879,10 +1578,21 @@ Special thanks to Pieter Wuille for providng reference implementations.
 Ethereum Address
 
 This validates that a string value contains a valid ethereum address.
-The format of the string is checked to ensure it matches the standard Ethereum address format.
+The format of the string is checked to ensure it matches the standard Ethereum address format;
+"0x" followed by 40 hex digits. Mixed case addresses must match their EIP-55 checksum,
+all lower or all upper case addresses carry no checksum and are accepted.
 
 	Usage: eth_addr
 
+Ethereum Address Checksum
+
+This validates that a string value contains a valid ethereum address whose mixed case
+matches its EIP-55 checksum (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-55.md).
+Unlike eth_addr, addresses without a checksum, all lower or all upper case, are rejected
+unless their case coincidentally matches the checksum.
+
+	Usage: eth_addr_checksum
+
 Contains
 
 This validates that a string value contains the substring value.
@@ -948,18 +1658,21 @@ This validates that a string value does not end with the supplied string value
 International Standard Book Number
 
 This validates that a string value contains a valid isbn10 or isbn13 value.
+Hyphens and spaces separating the parts of the ISBN are ignored.
 
 	Usage: isbn
 
 International Standard Book Number 10
 
-This validates that a string value contains a valid isbn10 value.
+This validates that a string value contains a valid isbn10 value, verifying
+the mod 11 check digit which may be 'X' or 'x'. Hyphens and spaces are ignored.
 
 	Usage: isbn10
 
 International Standard Book Number 13
 
-This validates that a string value contains a valid isbn13 value.
+This validates that a string value contains a valid isbn13 value, with a 978
+or 979 prefix, verifying the mod 10 check digit. Hyphens and spaces are ignored.
 
 	Usage: isbn13
 
@@ -1015,6 +1728,16 @@ NOTE: this will also validate that the data portion is valid base64
 
 	Usage: datauri
 
+Data URL Image
+
+This validates that a string value contains a valid DataURI strictly following
+the data:[<mediatype>][;base64],<data> grammar of RFC 2397, whose mediatype is
+an image eg. image/png. Mediatype parameters must be attribute=value pairs and
+the data must be valid base64 when ;base64 is present, or valid URL escaped
+octets otherwise.
+
+	Usage: datauri_image
+
 Latitude
 
 This validates that a string value contains a valid latitude.
@@ -1142,21 +1865,35 @@ Note: See Go's ParseMAC for accepted formats and types:
 Hostname RFC 952
 
 This validates that a string value is a valid Hostname according to RFC 952 https://tools.ietf.org/html/rfc952
+and that it is at most 253 characters long with no label longer than 63 characters.
 
 	Usage: hostname
 
 Hostname RFC 1123
 
 This validates that a string value is a valid Hostname according to RFC 1123 https://tools.ietf.org/html/rfc1123
+and that it is at most 253 characters long with no label longer than 63 characters.
 
 	Usage: hostname_rfc1123 or if you want to continue to use 'hostname' in your tags, create an alias.
 
 Full Qualified Domain Name (FQDN)
 
-This validates that a string value contains a valid FQDN.
+This validates that a string value contains a valid FQDN; at least two labels,
+the last being a non numerical TLD, optionally followed by a trailing dot.
+Labels may only contain letters, digits and hyphens and be at most 63 characters
+long, the whole name at most 253 characters excluding the trailing dot.
+Internationalized domain names must be supplied in their ASCII (punycode)
+form eg. xn--mnchen-3ya.de rather than münchen.de.
 
 	Usage: fqdn
 
+FQDN Relaxed
+
+This validates the same as fqdn, but also allows underscores within labels
+as commonly found in DNS records such as _sip._tcp.example.com.
+
+	Usage: fqdn_relaxed
+
 HTML Tags
 
 This validates that a string value appears to be an HTML element tag
@@ -1200,6 +1937,27 @@ Supplied format must match the official Go time format layout as documented in h
 
 	Usage: datetime=2006-01-02
 
+Datetime Any
+
+This validates that a string value is a valid datetime in any of the '|'
+separated layouts, as datetime does with each, eg. for APIs accepting either a
+date or a full timestamp. The layouts can't be or'd with other validations.
+
+	Usage: datetime_any=2006-01-02|2006-01-02T15:04:05Z07:00
+
+Named Datetime Formats
+
+These validate that a string value is a valid datetime in a common format, as
+datetime does with the equivalent layout: rfc3339 (2006-01-02T15:04:05Z07:00),
+rfc3339_nano (2006-01-02T15:04:05.999999999Z07:00), date (2006-01-02) and
+time (15:04:05). As with time.Parse, fractional seconds are accepted following
+the seconds of rfc3339 and are optional for rfc3339_nano.
+
+	Usage: rfc3339
+	Usage: rfc3339_nano
+	Usage: date
+	Usage: time
+
 Iso3166-1 alpha-2
 
 This validates that a string value is a valid country code based on iso3166-1 alpha-2 standard.
@@ -1237,12 +1995,24 @@ More information on https://www.iso.org/standard/60390.html
 
 TimeZone
 
-This validates that a string value is a valid time zone based on the time zone database present on the system.
-Although empty value and Local value are allowed by time.LoadLocation golang function, they are not allowed by this validator.
-More information on https://golang.org/pkg/time/#LoadLocation
+This validates that a string value is a valid IANA time zone based on the time zone database present on the system,
+using time.LoadLocation. Successful lookups are cached so the time zone database is only consulted once per name.
+Although an empty value is allowed by time.LoadLocation golang function, and treated as UTC, it is not allowed by this
+validator. The special names "UTC" and "Local" are both accepted, note that "Local" resolves to the time zone of the
+system the program is running on. More information on https://golang.org/pkg/time/#LoadLocation
 
 	Usage: timezone
 
+Regular Expression
+
+This validates that a string value is a regular expression pattern compiling
+using regexp.Compile, eg. a pattern read from configuration. The most recently
+used successful compilations are cached so repeated patterns aren't recompiled.
+A *regexp.Regexp value is compiled already so is valid when not nil; like
+time.Time it is validated as a value rather than descended into.
+
+	Usage: regexp
+
 
 Alias Validators and Tags
 
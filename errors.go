@@ -2,15 +2,22 @@ package validator
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	ut "github.com/go-playground/universal-translator"
 )
 
 const (
 	fieldErrMsg = "Key: '%s' Error:Field validation for '%s' failed on the '%s' tag"
+
+	// maxValueStringLen is the number of characters of a value rendered by ValueString after which it's truncated
+	maxValueStringLen = 64
+	redactedValue     = "[REDACTED]"
 )
 
 // ValidationErrorsTranslations is the translation return type
@@ -19,7 +26,15 @@ type ValidationErrorsTranslations map[string]string
 // InvalidValidationError describes an invalid argument passed to
 // `Struct`, `StructExcept`, StructPartial` or `Field`
 type InvalidValidationError struct {
-	Type reflect.Type
+	Type reflect.Type // type of the argument, nil when passed nil
+	Kind reflect.Kind // kind of the argument after dereferencing any non-nil pointers
+
+	// valueStruct is set when the argument is a struct validated as a value, eg. time.Time or a type registered
+	// using RegisterOpaqueType, rather than by its fields
+	valueStruct bool
+
+	// reason, when set, is the message explaining why an otherwise valid struct was rejected
+	reason string
 }
 
 // Error returns InvalidValidationError message
@@ -29,7 +44,56 @@ func (e *InvalidValidationError) Error() string {
 		return "validator: (nil)"
 	}
 
-	return "validator: (nil " + e.Type.String() + ")"
+	if len(e.reason) > 0 {
+		return "validator: " + e.reason
+	}
+
+	if e.Kind == reflect.Ptr || e.Kind == reflect.Interface {
+		return "validator: (nil " + e.Type.String() + ")"
+	}
+
+	if e.valueStruct {
+		return "validator: " + e.Type.String() + " is validated as a value rather than by its fields" +
+			", use Var to validate a single variable"
+	}
+
+	return "validator: expected a struct but got " + e.Type.String() + " of kind " + e.Kind.String() +
+		", use Var to validate a single variable"
+}
+
+// IsInvalidValidationError returns whether the error, or any error it wraps, is an InvalidValidationError
+// meaning the value passed in couldn't be validated, as opposed to failing validation.
+func IsInvalidValidationError(err error) bool {
+	var ive *InvalidValidationError
+	return errors.As(err, &ive)
+}
+
+// MissingTagsError is returned, instead of validating, when a struct required to have a validation tag
+// on every exported field, using SetRequireAllFieldsHaveTags or RegisterRequireAllFieldsHaveTags, has
+// fields lacking one.
+type MissingTagsError struct {
+	Tag    string
+	Fields []string // struct namespaces of the untagged fields
+}
+
+// Error returns MissingTagsError message
+func (e *MissingTagsError) Error() string {
+	return "validator: fields missing a '" + e.Tag + "' tag: " + strings.Join(e.Fields, ", ")
+}
+
+// TagKindError is returned by ValidateTagForType when a built-in validation is applied to a type of a kind it
+// doesn't support, eg. email to an int, or dive to a type which isn't a collection.
+type TagKindError struct {
+	Tag  string
+	Type reflect.Type // type the validation would be run against, after dereferencing pointers and any dives
+}
+
+// Error returns TagKindError message
+func (e *TagKindError) Error() string {
+	if e.Tag == diveTag {
+		return "validator: dive used on non-collection type " + e.Type.String() + " of kind " + e.Type.Kind().String()
+	}
+	return "validator: tag '" + e.Tag + "' doesn't support " + e.Type.String() + " of kind " + e.Type.Kind().String()
 }
 
 // ValidationErrors is an array of FieldError's
@@ -56,7 +120,9 @@ func (ve ValidationErrors) Error() string {
 	return strings.TrimSpace(buff.String())
 }
 
-// Translate translates all of the ValidationErrors
+// Translate translates all of the ValidationErrors, returning a map of each error's namespace to its
+// message; the shape frontends usually consume. Errors are translated using the TranslationFunc registered
+// for their tag and translator, falling back to the untranslated error message for unregistered tags.
 func (ve ValidationErrors) Translate(ut ut.Translator) ValidationErrorsTranslations {
 
 	trans := make(ValidationErrorsTranslations)
@@ -73,12 +139,153 @@ func (ve ValidationErrors) Translate(ut ut.Translator) ValidationErrorsTranslati
 		// 	continue
 		// }
 
-		trans[fe.ns] = fe.Translate(ut)
+		trans[fe.Namespace()] = fe.Translate(ut)
 	}
 
 	return trans
 }
 
+// Tree reconstructs the struct hierarchy from the errors namespaces, relative to the top level
+// struct, returning a nested map whose leaves are the FieldError's. Numeric indexes, including numeric
+// map keys, become nested slices padded with nil for valid elements, and other map keys nested maps.
+//
+// eg. User.Users[0].Email becomes {"Users": []interface{}{{"Email": FieldError}}}
+//
+// When a field has both an error of its own and errors nested beneath it, eg. a struct level error
+// reported against a struct field, its own error is stored under the empty "" key of the nested map;
+// a nested slice is converted to a map keyed by the index to do so. Errors of a validation performed
+// on a single variable, without a namespace, are stored under the "" key of the returned map.
+func (ve ValidationErrors) Tree() map[string]interface{} {
+
+	tree := make(map[string]interface{})
+
+	var fe *fieldError
+
+	for i := 0; i < len(ve); i++ {
+		fe = ve[i].(*fieldError)
+		tree = insertErrorTree(tree, fe.v.splitNamespace(fe.ns[fe.rootLen:]), fe).(map[string]interface{})
+	}
+
+	return tree
+}
+
+// GroupByParent groups the errors by the namespace of their parent, their namespace with the final segment
+// removed, in the order they were reported; eg. User.Address.Zip is grouped under User.Address and the
+// element error User.Tags[1] under User.Tags. Errors without a parent are grouped under the empty "" key.
+func (ve ValidationErrors) GroupByParent() map[string]ValidationErrors {
+
+	groups := make(map[string]ValidationErrors)
+
+	var fe *fieldError
+
+	for i := 0; i < len(ve); i++ {
+		fe = ve[i].(*fieldError)
+		parent := fe.v.parentNamespace(fe.Namespace())
+		groups[parent] = append(groups[parent], fe)
+	}
+
+	return groups
+}
+
+// ByTopField groups the error messages by the top level struct field they were reported beneath, in the order
+// they were reported, as form libraries commonly display them; eg. the errors of User.Address.Zip and
+// User.Address.City are both grouped under Address. Field names are those of Namespace, so honour
+// RegisterTagNameFunc. Errors of a validation performed on a single variable are grouped under the empty "" key.
+func (ve ValidationErrors) ByTopField() map[string][]string {
+
+	fields := make(map[string][]string)
+
+	var fe *fieldError
+
+	for i := 0; i < len(ve); i++ {
+		fe = ve[i].(*fieldError)
+
+		var top string
+		if segs := fe.v.splitNamespace(fe.ns[fe.rootLen:]); len(segs) > 0 {
+			top = segs[0].name
+		}
+
+		fields[top] = append(fields[top], fe.Error())
+	}
+
+	return fields
+}
+
+// insertErrorTree inserts the error into node at the path described by segs, returning
+// the, possibly new or converted, node.
+func insertErrorTree(node interface{}, segs []nsSegment, fe FieldError) interface{} {
+
+	if len(segs) == 0 {
+		switch n := node.(type) {
+		case nil:
+			return fe
+		case map[string]interface{}:
+			if _, ok := n[""]; !ok {
+				n[""] = fe
+			}
+			return n
+		case []interface{}:
+			m := errorSliceToMap(n)
+			m[""] = fe
+			return m
+		default:
+			// keep the first error reported for the field
+			return n
+		}
+	}
+
+	seg := segs[0]
+	idx, isIndex := -1, false
+
+	if seg.bracket {
+		if i, err := strconv.Atoi(seg.name); err == nil && i >= 0 {
+			idx, isIndex = i, true
+		}
+	}
+
+	switch n := node.(type) {
+	case nil:
+		if isIndex {
+			s := make([]interface{}, idx+1)
+			s[idx] = insertErrorTree(nil, segs[1:], fe)
+			return s
+		}
+		return map[string]interface{}{seg.name: insertErrorTree(nil, segs[1:], fe)}
+
+	case []interface{}:
+		if isIndex {
+			for len(n) <= idx {
+				n = append(n, nil)
+			}
+			n[idx] = insertErrorTree(n[idx], segs[1:], fe)
+			return n
+		}
+		m := errorSliceToMap(n)
+		m[seg.name] = insertErrorTree(m[seg.name], segs[1:], fe)
+		return m
+
+	case map[string]interface{}:
+		n[seg.name] = insertErrorTree(n[seg.name], segs[1:], fe)
+		return n
+
+	default:
+		// the field has an error of its own, which moves under the "" key
+		m := map[string]interface{}{"": n}
+		return insertErrorTree(m, segs, fe)
+	}
+}
+
+// errorSliceToMap converts a nested slice into a map keyed by index, omitting nil elements.
+func errorSliceToMap(s []interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(s)+1)
+	for i := 0; i < len(s); i++ {
+		if s[i] != nil {
+			m[strconv.Itoa(i)] = s[i]
+		}
+	}
+	return m
+}
+
 // FieldError contains all functions to get error details
 type FieldError interface {
 
@@ -98,6 +305,12 @@ type FieldError interface {
 	// will return "hexcolor|rgb|rgba|hsl|hsla"
 	ActualTag() string
 
+	// Code returns the machine readable error code registered for the failed
+	// tag using RegisterErrorCode, or the tag itself when none was registered.
+	//
+	// eg. RegisterErrorCode("required", "E001") will return "E001"
+	Code() string
+
 	// Namespace returns the namespace for the field error, with the tag
 	// name taking precedence over the field's actual name.
 	//
@@ -118,6 +331,13 @@ type FieldError interface {
 	// using validate.Field(...) as there is no way to extract its name
 	StructNamespace() string
 
+	// PathSegments returns the segments of StructNamespace, the struct's and field's
+	// actual names, with slice and array indexes and map keys as separate segments
+	// so the path doesn't need to be re-parsed.
+	//
+	// eg. "User.Addresses[0].City" will return ["User", "Addresses", "0", "City"]
+	PathSegments() []string
+
 	// Field returns the fields name with the tag name taking precedence over the
 	// field's actual name.
 	//
@@ -135,6 +355,11 @@ type FieldError interface {
 	// message
 	Value() interface{}
 
+	// ValueString returns the actual field's value rendered for display in an error
+	// message, truncated after 64 characters, or "[REDACTED]" when the field has
+	// the tag set using SetSensitiveTag.
+	ValueString() string
+
 	// Param returns the param value, in string form for comparison; this will also
 	// help with generating an error message
 	Param() string
@@ -175,6 +400,8 @@ type fieldError struct {
 	structNs       string
 	fieldLen       uint8
 	structfieldLen uint8
+	rootLen        int // length of the top level struct name and separator prefixing ns and structNs
+	sensitive      bool
 	value          interface{}
 	param          string
 	kind           reflect.Kind
@@ -192,16 +419,51 @@ func (fe *fieldError) ActualTag() string {
 	return fe.actualTag
 }
 
+// Code returns the error code registered for the failed tag, defaulting
+// to the tag.
+func (fe *fieldError) Code() string {
+	if code, ok := fe.v.errorCodes[fe.tag]; ok {
+		return code
+	}
+	return fe.tag
+}
+
 // Namespace returns the namespace for the field error, with the tag
 // name taking precedence over the field's actual name.
 func (fe *fieldError) Namespace() string {
-	return fe.ns
+	return fe.omitTop(fe.ns)
 }
 
 // StructNamespace returns the namespace for the field error, with the field's
 // actual name.
 func (fe *fieldError) StructNamespace() string {
-	return fe.structNs
+	return fe.omitTop(fe.structNs)
+}
+
+// omitTop removes the top level struct name from ns when SetOmitTopNamespace is enabled, keeping any index or
+// key added by StructAll, StructMap and the like.
+func (fe *fieldError) omitTop(ns string) string {
+	if !fe.v.omitTopNs || fe.rootLen == 0 {
+		return ns
+	}
+
+	if left := fe.v.nsLeftBracket; len(left) > 0 {
+		if idx := strings.Index(ns[:fe.rootLen], left); idx != -1 {
+			return ns[idx:]
+		}
+	}
+
+	return ns[fe.rootLen:]
+}
+
+// PathSegments returns the segments of the struct namespace.
+func (fe *fieldError) PathSegments() []string {
+	segs := fe.v.splitNamespace(fe.StructNamespace())
+	path := make([]string, len(segs))
+	for i := 0; i < len(segs); i++ {
+		path[i] = segs[i].name
+	}
+	return path
 }
 
 // Field returns the field's name with the tag name taking precedence over the
@@ -233,6 +495,22 @@ func (fe *fieldError) Value() interface{} {
 	return fe.value
 }
 
+// ValueString returns the actual field's value rendered for display, truncated or
+// redacted when sensitive
+func (fe *fieldError) ValueString() string {
+	if fe.sensitive {
+		return redactedValue
+	}
+
+	s := fmt.Sprint(fe.value)
+
+	if utf8.RuneCountInString(s) > maxValueStringLen {
+		s = string([]rune(s)[:maxValueStringLen]) + "..."
+	}
+
+	return s
+}
+
 // Param returns the param value, in string form for comparison; this will
 // also help with generating an error message
 func (fe *fieldError) Param() string {
@@ -251,7 +529,7 @@ func (fe *fieldError) Type() reflect.Type {
 
 // Error returns the fieldError's error message
 func (fe *fieldError) Error() string {
-	return fmt.Sprintf(fieldErrMsg, fe.ns, fe.Field(), fe.tag)
+	return fmt.Sprintf(fieldErrMsg, fe.Namespace(), fe.Field(), fe.tag)
 }
 
 // Translate returns the FieldError's translated error
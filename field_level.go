@@ -1,6 +1,9 @@
 package validator
 
-import "reflect"
+import (
+	"context"
+	"reflect"
+)
 
 // FieldLevel contains all the information and helper functions
 // to validate a field
@@ -13,9 +16,22 @@ type FieldLevel interface {
 	// the comparison value if called 'VarWithValue'
 	Parent() reflect.Value
 
-	// Field returns current field for validation
+	// Field returns current field for validation, dereferenced and with any
+	// CustomTypeFunc applied; a nil pointer is returned as is.
 	Field() reflect.Value
 
+	// FieldRaw returns current field for validation as found, prior to
+	// dereferencing pointers and interfaces or applying a CustomTypeFunc.
+	FieldRaw() reflect.Value
+
+	// Kind returns the kind of Field(), the dereferenced value with any
+	// CustomTypeFunc applied; reflect.Ptr for a nil pointer.
+	Kind() reflect.Kind
+
+	// Type returns the type of Field(), the dereferenced value with any
+	// CustomTypeFunc applied, or nil when it's invalid eg. a nil interface.
+	Type() reflect.Type
+
 	// FieldName returns the field's name with the tag
 	// name taking precedence over the fields actual name.
 	FieldName() string
@@ -26,9 +42,30 @@ type FieldLevel interface {
 	// Param returns param for validation against current field
 	Param() string
 
-	// GetTag returns the current validations tag name
+	// Params returns the param for validation against current field split
+	// on whitespace, values containing spaces can be grouped using single
+	// quotes eg. between='a b' c returns [a b, c].
+	Params() []string
+
+	// GetTag returns the current validations tag name, allowing a single
+	// function registered against several tags to branch on the tag.
+	// When validating an alias the underlying tag is returned, not the alias.
 	GetTag() string
 
+	// ReportErrorTag sets the tag recorded in the FieldError, and used to translate it, should the validation
+	// fail, in place of the tag being validated; allowing a validation to report why it failed eg. too_long
+	// rather than length. An empty tag restores the default. It has no effect when the validation passes, nor
+	// on validations or'd together.
+	ReportErrorTag(tag string)
+
+	// Context returns the context passed to the validation eg. using StructCtx or VarCtx,
+	// context.Background() when validating without one.
+	Context() context.Context
+
+	// ContextValue returns the value associated with key in Context(), or nil;
+	// see Validate.WithValue.
+	ContextValue(key interface{}) interface{}
+
 	// ExtractType gets the actual underlying type of field value.
 	// It will dive into pointers, customTypes and return you the
 	// underlying value and it's kind.
@@ -70,6 +107,24 @@ func (v *validate) Field() reflect.Value {
 	return v.flField
 }
 
+// FieldRaw returns current field for validation prior to extraction
+func (v *validate) FieldRaw() reflect.Value {
+	return v.flFieldRaw
+}
+
+// Kind returns the kind of the current field for validation
+func (v *validate) Kind() reflect.Kind {
+	return v.flField.Kind()
+}
+
+// Type returns the type of the current field for validation
+func (v *validate) Type() reflect.Type {
+	if !v.flField.IsValid() {
+		return nil
+	}
+	return v.flField.Type()
+}
+
 // FieldName returns the field's name with the tag
 // name taking precedence over the fields actual name.
 func (v *validate) FieldName() string {
@@ -91,6 +146,32 @@ func (v *validate) Param() string {
 	return v.ct.param
 }
 
+// Params returns the param for validation against current field split on whitespace
+func (v *validate) Params() []string {
+	vals := parseOneOfParam2(v.ct.param)
+	params := make([]string, len(vals))
+	copy(params, vals)
+	return params
+}
+
+// ReportErrorTag sets the tag to report should the current validation fail
+func (v *validate) ReportErrorTag(tag string) {
+	v.errTag = tag
+}
+
+// Context returns the context passed to the validation
+func (v *validate) Context() context.Context {
+	if v.ctx == nil {
+		return context.Background()
+	}
+	return v.ctx
+}
+
+// ContextValue returns the value associated with key in the validation's context
+func (v *validate) ContextValue(key interface{}) interface{} {
+	return v.Context().Value(key)
+}
+
 // GetStructFieldOK returns Param returns param for validation against current field
 //
 // Deprecated: Use GetStructFieldOK2() instead which also return if the value is nullable.
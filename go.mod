@@ -8,5 +8,5 @@ require (
 	github.com/go-playground/universal-translator v0.17.0
 	github.com/leodido/go-urn v1.2.0
 	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
-	golang.org/x/text v0.3.2 // indirect
+	golang.org/x/text v0.3.2
 )
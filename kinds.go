@@ -0,0 +1,288 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+)
+
+var (
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	regexpType   = reflect.TypeOf(regexp.Regexp{})
+)
+
+// typeSupport reports whether a built-in validation supports values of the type, after dereferencing pointers.
+type typeSupport func(t reflect.Type) bool
+
+// bakedInKinds holds the types supported by the built-in validations restricted to certain kinds, used by
+// ValidateTagForType; validations not listed, including those registered using RegisterValidation, may be
+// applied to any type.
+var bakedInKinds = map[string]typeSupport{
+	"len":                           isSizedType,
+	"min":                           isSizedOrTimeType,
+	"max":                           isSizedOrTimeType,
+	"gt":                            isSizedOrTimeType,
+	"gte":                           isSizedOrTimeType,
+	"lt":                            isSizedOrTimeType,
+	"lte":                           isSizedOrTimeType,
+	"eq":                            isComparableLiteralType,
+	"ne":                            isComparableLiteralType,
+	"oneof":                         isOneOfType,
+	"rune_in":                       isOneOfType,
+	"alpha":                         isStringType,
+	"alphanum":                      isStringType,
+	"alphaunicode":                  isStringType,
+	"alphanumunicode":               isStringType,
+	"alphaspace":                    isStringType,
+	"alphanumspace":                 isStringType,
+	"alphaspaceunicode":             isStringType,
+	"alphanumspaceunicode":          isStringType,
+	"ascii":                         isStringType,
+	"printascii":                    isStringType,
+	"multibyte":                     isStringType,
+	"lowercase":                     isStringType,
+	"uppercase":                     isStringType,
+	"contains":                      isStringType,
+	"containsany":                   isStringType,
+	"containsrune":                  isStringType,
+	"excludes":                      isStringType,
+	"excludesall":                   isStringType,
+	"excludesrune":                  isStringType,
+	"startswith":                    isStringType,
+	"endswith":                      isStringType,
+	"startsnotwith":                 isStringType,
+	"endsnotwith":                   isStringType,
+	"email":                         isStringType,
+	"url":                           isStringType,
+	"uri":                           isStringType,
+	"base64":                        isStringType,
+	"base64url":                     isStringType,
+	"hexadecimal":                   isStringType,
+	"hexcolor":                      isStringType,
+	"rgb":                           isStringType,
+	"rgba":                          isStringType,
+	"hsl":                           isStringType,
+	"hsla":                          isStringType,
+	"e164":                          isStringType,
+	"uuid":                          isStringType,
+	"uuid3":                         isStringType,
+	"uuid4":                         isStringType,
+	"uuid5":                         isStringType,
+	"uuid_rfc4122":                  isStringType,
+	"isbn":                          isStringType,
+	"isbn10":                        isStringType,
+	"isbn13":                        isStringType,
+	"ip":                            isStringType,
+	"ipv4":                          isStringType,
+	"ipv6":                          isStringType,
+	"cidr":                          isStringType,
+	"cidrv4":                        isStringType,
+	"cidrv6":                        isStringType,
+	"mac":                           isStringType,
+	"hostname":                      isStringType,
+	"hostname_rfc1123":              isStringType,
+	"fqdn":                          isStringType,
+	"datetime":                      isStringType,
+	"datetime_any":                  isStringType,
+	"timezone":                      isStringType,
+	"regexp":                        isRegexpType,
+	"rfc3339":                       isStringType,
+	"rfc3339_nano":                  isStringType,
+	"date":                          isStringType,
+	"time":                          isStringType,
+	"fqdn_relaxed":                  isStringType,
+	"password":                      isStringType,
+	"oneofci":                       isStringType,
+	"uri_path":                      isStringType,
+	"urn_rfc2141":                   isStringType,
+	"file":                          isStringType,
+	"filepath":                      isStringType,
+	"dir":                           isStringType,
+	"json":                          isStringType,
+	"html":                          isStringType,
+	"html_encoded":                  isStringType,
+	"url_encoded":                   isStringType,
+	"datauri":                       isStringType,
+	"datauri_image":                 isStringType,
+	"eth_addr":                      isStringType,
+	"eth_addr_checksum":             isStringType,
+	"btc_addr":                      isStringType,
+	"btc_addr_bech32":               isStringType,
+	"uuid3_rfc4122":                 isStringType,
+	"uuid4_rfc4122":                 isStringType,
+	"uuid5_rfc4122":                 isStringType,
+	"ssn":                           isStringType,
+	"hostname_port":                 isStringType,
+	"tcp4_addr":                     isStringType,
+	"tcp6_addr":                     isStringType,
+	"tcp_addr":                      isStringType,
+	"udp4_addr":                     isStringType,
+	"udp6_addr":                     isStringType,
+	"udp_addr":                      isStringType,
+	"ip4_addr":                      isStringType,
+	"ip6_addr":                      isStringType,
+	"ip_addr":                       isStringType,
+	"unix_addr":                     isStringType,
+	"iso3166_1_alpha2":              isStringType,
+	"iso3166_1_alpha3":              isStringType,
+	"bcp47_language_tag":            isStringType,
+	"postcode_iso3166_alpha2":       isStringType,
+	"postcode_iso3166_alpha2_field": isStringType,
+	"bic":                           isStringType,
+	"iso3166_1_alpha_numeric":       isIntegerType,
+	"numeric":                       isStringOrNumberType,
+	"number":                        isStringOrNumberType,
+	"latitude":                      isStringOrNumberType,
+	"longitude":                     isStringOrNumberType,
+	"digits":                        isOneOfType,
+	"digits_between":                isOneOfType,
+	"inset":                         isOneOfType,
+	"unique":                        isCollectionType,
+	"future":                        isTimeType,
+	"past":                          isTimeType,
+	"gtnow":                         isTimeType,
+	"ltnow":                         isTimeType,
+	"agemin":                        isTimeType,
+	"agemax":                        isTimeType,
+}
+
+// isStringType reports whether the type is a string, or a []byte validated as its contents.
+func isStringType(t reflect.Type) bool {
+	return t.Kind() == reflect.String || (t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8)
+}
+
+func isTimeType(t reflect.Type) bool {
+	return t == timeType
+}
+
+func isIntegerType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+func isStringOrNumberType(t reflect.Type) bool {
+	return isStringType(t) || isNumberType(t)
+}
+
+func isCollectionType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+	return false
+}
+
+func isRegexpType(t reflect.Type) bool {
+	return t == regexpType || isStringType(t)
+}
+
+func isNumberType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// isSizedType reports whether the type has a length or is a number, as required by len.
+func isSizedType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return true
+	}
+	return isNumberType(t)
+}
+
+func isSizedOrTimeType(t reflect.Type) bool {
+	return t == timeType || isSizedType(t)
+}
+
+func isComparableLiteralType(t reflect.Type) bool {
+	return t.Kind() == reflect.Bool || isSizedType(t)
+}
+
+func isOneOfType(t reflect.Type) bool {
+	return isStringType(t) || isIntegerType(t)
+}
+
+// isStringerLength reports whether the tag validates the length of the type's String() output, as enabled using
+// RegisterStringerLength.
+func (v *Validate) isStringerLength(tag string, t reflect.Type) bool {
+	switch tag {
+	case "len", "min", "max":
+		return v.stringerLength && (t.Implements(stringerType) || reflect.PtrTo(t).Implements(stringerType))
+	}
+	return false
+}
+
+// parseTagErr parses the tag as parseFieldTagsRecursive does, returning the panic of an invalid tag as an error.
+func (v *Validate) parseTagErr(tag string) (ct *cTag, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("validator: %v", r)
+		}
+	}()
+
+	ct, _ = v.parseFieldTagsRecursive(tag, "", "", false)
+	return
+}
+
+// checkTagKinds returns a TagKindError for the first validation of the chain ct not supporting the type it would
+// be run against, following dives into element and map key types; only dives into types which aren't collections
+// are reported unless all is set. Checking stops at types whose kind is only known while validating, such as
+// interfaces and types with a CustomTypeFunc.
+func (v *Validate) checkTagKinds(ct *cTag, t reflect.Type, all bool) error {
+	var container reflect.Type
+
+	for ; ct != nil; ct = ct.next {
+
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		if t.Kind() == reflect.Interface {
+			return nil
+		}
+
+		if _, ok := v.customFuncs[t]; ok {
+			return nil
+		}
+
+		switch ct.typeof {
+		case typeDive:
+			switch t.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				container, t = t, t.Elem()
+			default:
+				// iterated using a RegisterIterator func
+				if _, ok := v.iterators[t]; ok {
+					return nil
+				}
+				return &TagKindError{Tag: diveTag, Type: t}
+			}
+
+		case typeKeys:
+			if container != nil && container.Kind() == reflect.Map {
+				if err := v.checkTagKinds(ct.keys, container.Key(), all); err != nil {
+					return err
+				}
+			}
+
+		case typeDefault, typeOr, typeIsDefault, typeSkip:
+			if !all {
+				continue
+			}
+			if supports, ok := bakedInKinds[ct.tag]; ok && !supports(t) && !v.isStringerLength(ct.tag, t) {
+				return &TagKindError{Tag: ct.tag, Type: t}
+			}
+		}
+	}
+
+	return nil
+}
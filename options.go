@@ -0,0 +1,65 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Option configures a Validate instance when passed to New, allowing construction to be declarative
+// rather than mutating the instance, which is not thread-safe, after it has been created.
+type Option func(*Validate)
+
+// WithTagName sets the tag name used in place of the default of 'validate', as SetTagName does.
+func WithTagName(name string) Option {
+	return func(v *Validate) {
+		v.SetTagName(name)
+	}
+}
+
+// WithJSONTagNames uses the name of a field's json tag, when present, as its name in errors.
+// Fields tagged json:"-" keep their Go field name.
+func WithJSONTagNames() Option {
+	return func(v *Validate) {
+		v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+			name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
+	}
+}
+
+// WithRequiredStructEnabled enables the required validations, required and the required_* tags, on non-pointer
+// struct fields which by default are only descended into; as the first tag of the field it is run against the
+// struct value before its fields are validated, eg. `validate:"required"` failing on a zero value struct.
+func WithRequiredStructEnabled() Option {
+	return func(v *Validate) {
+		v.requiredStructs = true
+	}
+}
+
+// WithSortedMapKeys dives into maps in sorted key order, rather than Go's random map iteration order, so that
+// the errors of map entries are returned in a deterministic order; at the cost of sorting the keys of each map.
+func WithSortedMapKeys() Option {
+	return func(v *Validate) {
+		v.sortMapKeys = true
+	}
+}
+
+// WithNowFunc sets the clock used by the time based baked in validations, as SetNowFunc does.
+func WithNowFunc(fn func() time.Time) Option {
+	return func(v *Validate) {
+		v.SetNowFunc(fn)
+	}
+}
+
+// WithStdlibOpaqueTypes registers common standard library struct types, time.Location, url.URL, big.Int,
+// big.Float, big.Rat and regexp.Regexp, as opaque as RegisterOpaqueType does; the validation tags of the fields
+// holding them being run against them rather than ignored, as for time.Time.
+func WithStdlibOpaqueTypes() Option {
+	return func(v *Validate) {
+		v.RegisterOpaqueType(stdlibOpaqueTypes...)
+	}
+}
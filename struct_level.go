@@ -2,7 +2,9 @@ package validator
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
 )
 
 // StructLevelFunc accepts all values needed for struct level validation
@@ -19,6 +21,30 @@ func wrapStructLevelFunc(fn StructLevelFunc) StructLevelFuncCtx {
 	}
 }
 
+// Validatable is implemented by structs validating themselves at the struct level, as a StructLevelFunc
+// registered for the type would, after their fields have been validated.
+type Validatable interface {
+	Validate(sl StructLevel)
+}
+
+// ValidatableCtx is implemented by structs validating themselves at the struct level, as Validatable, that
+// also need the context.Context passed to StructCtx.
+type ValidatableCtx interface {
+	Validate(ctx context.Context, sl StructLevel)
+}
+
+var (
+	validatableType    = reflect.TypeOf((*Validatable)(nil)).Elem()
+	validatableCtxType = reflect.TypeOf((*ValidatableCtx)(nil)).Elem()
+)
+
+// implementsValidatable reports whether the struct type, or a pointer to it, implements Validatable or ValidatableCtx.
+func implementsValidatable(typ reflect.Type) bool {
+	ptr := reflect.PtrTo(typ)
+	return typ.Implements(validatableType) || typ.Implements(validatableCtxType) ||
+		ptr.Implements(validatableType) || ptr.Implements(validatableCtxType)
+}
+
 // StructLevel contains all the information and helper functions
 // to validate a struct
 type StructLevel interface {
@@ -127,6 +153,7 @@ func (v *validate) ReportError(field interface{}, fieldName, structFieldName, ta
 		v.errs = append(v.errs,
 			&fieldError{
 				v:              v.v,
+				rootLen:        v.rootLen,
 				tag:            tag,
 				actualTag:      tag,
 				ns:             v.str1,
@@ -143,6 +170,7 @@ func (v *validate) ReportError(field interface{}, fieldName, structFieldName, ta
 	v.errs = append(v.errs,
 		&fieldError{
 			v:              v.v,
+			rootLen:        v.rootLen,
 			tag:            tag,
 			actualTag:      tag,
 			ns:             v.str1,
@@ -169,7 +197,87 @@ func (v *validate) ReportValidationErrors(relativeNamespace, relativeStructNames
 		err = errs[i].(*fieldError)
 		err.ns = string(append(append(v.ns, relativeNamespace...), err.ns...))
 		err.structNs = string(append(append(v.actualNs, relativeStructNamespace...), err.structNs...))
+		err.rootLen = v.rootLen
 
 		v.errs = append(v.errs, err)
 	}
 }
+
+// tupleKey is a comparable key made up of the values of several fields, used by CheckUnique.
+type tupleKey struct {
+	head interface{}
+	tail interface{}
+}
+
+// CheckUnique is a helper for struct level validations checking that the tuples made up of the elements, at each
+// index, of the current struct's parallel slice or array fields are unique; eg. CheckUnique(sl, "Keys", "Regions")
+// fails when (Keys[0], Regions[0]) equals (Keys[2], Regions[2]). When the fields differ in length the tuples of
+// the indexes present in all of them are checked. Pointer elements are compared by the value pointed to, nil ones
+// being equal.
+//
+// On failure a 'unique' error is reported against the first field, with the fields joined by '+' as the param eg.
+// Keys+Regions, and false returned.
+//
+// It panics when no fields are given, or one isn't a slice or array field of the current struct; the elements
+// must be of comparable types.
+func CheckUnique(sl StructLevel, fields ...string) bool {
+
+	if len(fields) == 0 {
+		panic("CheckUnique requires at least one field")
+	}
+
+	current := sl.Current()
+	values := make([]reflect.Value, len(fields))
+	n := -1
+
+	for i := 0; i < len(fields); i++ {
+
+		field := current.FieldByName(fields[i])
+		if !field.IsValid() {
+			panic(fmt.Sprintf("Bad field name %s", fields[i]))
+		}
+
+		field = reflect.Indirect(field)
+
+		// a nil pointer has no elements, and so no tuples
+		if !field.IsValid() {
+			n = 0
+			continue
+		}
+
+		switch field.Kind() {
+		case reflect.Slice, reflect.Array:
+		default:
+			panic(fmt.Sprintf("Bad field type %s for field '%s'", field.Type(), fields[i]))
+		}
+
+		if n == -1 || field.Len() < n {
+			n = field.Len()
+		}
+
+		values[i] = field
+	}
+
+	seen := make(map[interface{}]struct{}, n)
+
+	for i := 0; i < n; i++ {
+
+		var key interface{}
+		for j := 0; j < len(values); j++ {
+			var val interface{}
+			if elem := reflect.Indirect(values[j].Index(i)); elem.IsValid() {
+				val = elem.Interface()
+			}
+			key = tupleKey{head: key, tail: val}
+		}
+
+		if _, ok := seen[key]; ok {
+			sl.ReportError(values[0].Interface(), fields[0], fields[0], "unique", strings.Join(fields, "+"))
+			return false
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	return true
+}
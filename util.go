@@ -1,7 +1,10 @@
 package validator
 
 import (
+	"bytes"
+	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -44,7 +47,22 @@ BEGIN:
 		if v.v.hasCustomFuncs {
 
 			if fn, ok := v.v.customFuncs[current.Type()]; ok {
+				typ := current.Type()
 				current = reflect.ValueOf(fn(current))
+
+				// a CustomTypeFuncE failed to extract the value
+				if current.IsValid() && current.Type() == customTypeFailureType {
+					v.customTypeErr = typ
+					v.customErrCause = current.Interface().(customTypeFailure).err
+					return reflect.Value{}, reflect.Invalid, nullable
+				}
+
+				// returning a reflect.Value or a value of the very same type is a misbehaving
+				// CustomTypeFunc, the former would be validated as a struct and the latter loop forever.
+				if current.IsValid() && (current.Type() == reflectValueType || current.Type() == typ) {
+					v.customTypeErr = typ
+					return reflect.Value{}, reflect.Invalid, nullable
+				}
 				goto BEGIN
 			}
 		}
@@ -53,6 +71,285 @@ BEGIN:
 	}
 }
 
+// customTypeFailure is returned by the CustomTypeFunc wrapping a CustomTypeFuncE when it fails.
+type customTypeFailure struct {
+	err error
+}
+
+var customTypeFailureType = reflect.TypeOf(customTypeFailure{})
+
+// sortMapKeys orders the keys of a map so that diving into it is deterministic.
+func sortMapKeys(keys []reflect.Value) {
+	sort.Slice(keys, func(i, j int) bool {
+		return compareKeys(keys[i], keys[j]) < 0
+	})
+}
+
+// compareKeys orders two map keys of the same type, returning -1, 0 or 1; numbers, strings and bools are
+// ordered by value, pointers and channels by address and arrays, structs and interfaces by their contents.
+func compareKeys(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareInt(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareUint(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return compareFloat(a.Float(), b.Float())
+	case reflect.Complex64, reflect.Complex128:
+		if c := compareFloat(real(a.Complex()), real(b.Complex())); c != 0 {
+			return c
+		}
+		return compareFloat(imag(a.Complex()), imag(b.Complex()))
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+	case reflect.Bool:
+		return compareBool(a.Bool(), b.Bool())
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		return compareUint(uint64(a.Pointer()), uint64(b.Pointer()))
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if c := compareKeys(a.Index(i), b.Index(i)); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if c := compareKeys(a.Field(i), b.Field(i)); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return compareBool(!a.IsNil(), !b.IsNil())
+		}
+		ae, be := a.Elem(), b.Elem()
+		if ae.Type() != be.Type() {
+			return strings.Compare(ae.Type().String(), be.Type().String())
+		}
+		return compareKeys(ae, be)
+	default:
+		return 0
+	}
+}
+
+// parseMapKey parses the key, as found between brackets in a field namespace, into
+// a value of the maps key type.
+func parseMapKey(typ reflect.Type, key string) reflect.Value {
+	var val interface{}
+	var err error
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		val, err = strconv.ParseInt(key, 10, typ.Bits())
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		val, err = strconv.ParseUint(key, 10, typ.Bits())
+
+	case reflect.Float32, reflect.Float64:
+		val, err = strconv.ParseFloat(key, typ.Bits())
+
+	case reflect.Bool:
+		val, err = strconv.ParseBool(key)
+
+	case reflect.String:
+		val = key
+
+	default:
+		// eg. interface{} keys, the key is looked up as is
+		if reflect.TypeOf(key).AssignableTo(typ) {
+			return reflect.ValueOf(key)
+		}
+		err = fmt.Errorf("unsupported map key type %s", typ)
+	}
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid map key '%s' in field namespace: %s", key, err))
+	}
+
+	return reflect.ValueOf(val).Convert(typ)
+}
+
+// nsSegment is a single segment of an error namespace, either a field name
+// or the contents of brackets following a slice, array or map field.
+type nsSegment struct {
+	name    string
+	bracket bool
+}
+
+// splitNamespace splits the namespace into its segments using the configured namespace
+// separator and brackets. When no right bracket is configured indexes and map keys
+// can't be told apart from field names and are returned as plain segments.
+func (v *Validate) splitNamespace(ns string) []nsSegment {
+	segs := make([]nsSegment, 0, 4)
+	sep, left, right := v.nsSeparator, v.nsLeftBracket, v.nsRightBracket
+	brackets := len(left) > 0 && len(right) > 0
+
+	for len(ns) > 0 {
+
+		if brackets && strings.HasPrefix(ns, left) {
+			end := strings.Index(ns[len(left):], right)
+			if end == -1 {
+				segs = append(segs, nsSegment{name: ns[len(left):], bracket: true})
+				break
+			}
+
+			segs = append(segs, nsSegment{name: ns[len(left) : len(left)+end], bracket: true})
+			ns = strings.TrimPrefix(ns[len(left)+end+len(right):], sep)
+			continue
+		}
+
+		end := strings.Index(ns, sep)
+		if brackets {
+			if idx := strings.Index(ns, left); idx != -1 && (end == -1 || idx < end) {
+				segs = append(segs, nsSegment{name: ns[:idx]})
+				ns = ns[idx:]
+				continue
+			}
+		}
+
+		if end == -1 {
+			segs = append(segs, nsSegment{name: ns})
+			break
+		}
+
+		segs = append(segs, nsSegment{name: ns[:end]})
+		ns = ns[end+len(sep):]
+	}
+
+	return segs
+}
+
+// parentNamespace returns ns with its final segment, a field name or an index or key, removed.
+func (v *Validate) parentNamespace(ns string) string {
+	left, right := v.nsLeftBracket, v.nsRightBracket
+
+	if len(left) > 0 && len(right) > 0 && strings.HasSuffix(ns, right) {
+		if idx := strings.LastIndex(ns, left); idx != -1 {
+			return ns[:idx]
+		}
+	}
+
+	if idx := strings.LastIndex(ns, v.nsSeparator); idx != -1 {
+		return ns[:idx]
+	}
+
+	return ""
+}
+
+// checkFieldTags returns a MissingTagsError listing the exported fields lacking a validation tag of the
+// struct type and of its nested structs declared within the same package, when required for the type.
+func (v *Validate) checkFieldTags(typ reflect.Type) error {
+	if !v.requireTags && len(v.requireTagsTypes) == 0 {
+		return nil
+	}
+
+	var missing []string
+	v.collectUntagged(typ, typ.PkgPath(), false, structName(typ)+v.nsSeparator, make(map[reflect.Type]struct{}), &missing)
+
+	if len(missing) > 0 {
+		return &MissingTagsError{Tag: v.tagName, Fields: missing}
+	}
+	return nil
+}
+
+// collectUntagged appends the namespaces of the untagged fields of the struct type to missing, recursing
+// into struct and pointer to struct fields.
+func (v *Validate) collectUntagged(typ reflect.Type, pkg string, required bool, ns string, seen map[reflect.Type]struct{}, missing *[]string) {
+	if _, ok := seen[typ]; ok {
+		return
+	}
+	seen[typ] = struct{}{}
+
+	_, registered := v.requireTagsTypes[typ]
+	required = registered || required || (v.requireTags && typ.PkgPath() == pkg)
+
+	cs, ok := v.structCache.Get(typ)
+	if !ok {
+		cs = v.extractStructCache(reflect.New(typ).Elem(), structName(typ))
+	}
+
+	if required {
+		for _, name := range cs.untagged {
+			*missing = append(*missing, ns+name)
+		}
+	}
+
+	for _, f := range cs.fields {
+		ft := typ.Field(f.idx).Type
+
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		if ft.Kind() != reflect.Struct || ft == timeType || v.isValueStruct(ft) {
+			continue
+		}
+
+		// only registered types, or those of the validated struct's own package, are checked past here
+		v.collectUntagged(ft, pkg, registered && ft.PkgPath() == typ.PkgPath(), ns+f.name+v.nsSeparator, seen, missing)
+	}
+}
+
+// isStructKey returns whether the map key is a struct, or pointer to one, other than time.Time whose fields
+// are to be validated when diving into the map.
+func isStructKey(key reflect.Value) bool {
+	for key.Kind() == reflect.Ptr && !key.IsNil() {
+		key = key.Elem()
+	}
+	return key.Kind() == reflect.Struct && key.Type() != timeType
+}
+
+// rulePath returns the path of the field, as addressed by StructWithRules, from the struct namespace of its
+// parent by removing the top level struct name and any slice, array or map indexes.
+func (v *validate) rulePath(structNs []byte, name string) string {
+	ns := string(structNs[v.rootLen:])
+	left, right := v.v.nsLeftBracket, v.v.nsRightBracket
+
+	if len(left) > 0 && len(right) > 0 {
+		var b strings.Builder
+
+		for {
+			start := strings.Index(ns, left)
+			if start == -1 {
+				break
+			}
+			end := strings.Index(ns[start+len(left):], right)
+			if end == -1 {
+				break
+			}
+			b.WriteString(ns[:start])
+			ns = ns[start+len(left)+end+len(right):]
+		}
+
+		b.WriteString(ns)
+		ns = b.String()
+	}
+
+	return ns + name
+}
+
+// typeOf returns the type of the reflected value or nil when the value is
+// the zero reflect.Value eg. reflect.ValueOf(nil)
+func typeOf(val reflect.Value) reflect.Type {
+	if !val.IsValid() {
+		return nil
+	}
+	return val.Type()
+}
+
+// structName returns the name of the struct type used as the top level namespace.
+// Instantiated generic types report their type arguments as part of the name eg.
+// Response[github.com/org/pkg.User], these are stripped so the namespace is Response.
+func structName(typ reflect.Type) string {
+	name := typ.Name()
+	if idx := strings.IndexByte(name, '['); idx != -1 {
+		name = name[:idx]
+	}
+	return name
+}
+
 // getStructFieldOKInternal traverses a struct to retrieve a specific field denoted by the provided namespace and
 // returns the field, field kind and whether is was successful in retrieving the field at all.
 //
@@ -101,6 +398,9 @@ BEGIN:
 			}
 
 			val = current.FieldByName(fld)
+			if !val.IsValid() && v.v.strictCrossField {
+				v.badCrossField = true
+			}
 			namespace = ns
 			goto BEGIN
 		}
@@ -109,7 +409,14 @@ BEGIN:
 		idx := strings.Index(namespace, leftBracket)
 		idx2 := strings.Index(namespace, rightBracket)
 
-		arrIdx, _ := strconv.Atoi(namespace[idx+1 : idx2])
+		if idx == -1 || idx2 < idx {
+			panic(fmt.Sprintf("Invalid field namespace '%s', expected an index", namespace))
+		}
+
+		arrIdx, err := strconv.Atoi(namespace[idx+1 : idx2])
+		if err != nil || arrIdx < 0 {
+			panic(fmt.Sprintf("Invalid index '%s' in field namespace", namespace[idx+1:idx2]))
+		}
 
 		if arrIdx >= current.Len() {
 			return
@@ -128,9 +435,13 @@ BEGIN:
 		goto BEGIN
 
 	case reflect.Map:
-		idx := strings.Index(namespace, leftBracket) + 1
+		idx := strings.Index(namespace, leftBracket)
 		idx2 := strings.Index(namespace, rightBracket)
 
+		if idx == -1 || idx2 < idx {
+			panic(fmt.Sprintf("Invalid field namespace '%s', expected a map key", namespace))
+		}
+
 		endIdx := idx2
 
 		if endIdx+1 < len(namespace) {
@@ -139,80 +450,8 @@ BEGIN:
 			}
 		}
 
-		key := namespace[idx:idx2]
-
-		switch current.Type().Key().Kind() {
-		case reflect.Int:
-			i, _ := strconv.Atoi(key)
-			val = current.MapIndex(reflect.ValueOf(i))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Int8:
-			i, _ := strconv.ParseInt(key, 10, 8)
-			val = current.MapIndex(reflect.ValueOf(int8(i)))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Int16:
-			i, _ := strconv.ParseInt(key, 10, 16)
-			val = current.MapIndex(reflect.ValueOf(int16(i)))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Int32:
-			i, _ := strconv.ParseInt(key, 10, 32)
-			val = current.MapIndex(reflect.ValueOf(int32(i)))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Int64:
-			i, _ := strconv.ParseInt(key, 10, 64)
-			val = current.MapIndex(reflect.ValueOf(i))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Uint:
-			i, _ := strconv.ParseUint(key, 10, 0)
-			val = current.MapIndex(reflect.ValueOf(uint(i)))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Uint8:
-			i, _ := strconv.ParseUint(key, 10, 8)
-			val = current.MapIndex(reflect.ValueOf(uint8(i)))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Uint16:
-			i, _ := strconv.ParseUint(key, 10, 16)
-			val = current.MapIndex(reflect.ValueOf(uint16(i)))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Uint32:
-			i, _ := strconv.ParseUint(key, 10, 32)
-			val = current.MapIndex(reflect.ValueOf(uint32(i)))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Uint64:
-			i, _ := strconv.ParseUint(key, 10, 64)
-			val = current.MapIndex(reflect.ValueOf(i))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Float32:
-			f, _ := strconv.ParseFloat(key, 32)
-			val = current.MapIndex(reflect.ValueOf(float32(f)))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Float64:
-			f, _ := strconv.ParseFloat(key, 64)
-			val = current.MapIndex(reflect.ValueOf(f))
-			namespace = namespace[endIdx+1:]
-
-		case reflect.Bool:
-			b, _ := strconv.ParseBool(key)
-			val = current.MapIndex(reflect.ValueOf(b))
-			namespace = namespace[endIdx+1:]
-
-		// reflect.Type = string
-		default:
-			val = current.MapIndex(reflect.ValueOf(key))
-			namespace = namespace[endIdx+1:]
-		}
-
+		val = current.MapIndex(parseMapKey(current.Type().Key(), namespace[idx+1:idx2]))
+		namespace = namespace[endIdx+1:]
 		goto BEGIN
 	}
 
@@ -220,6 +459,36 @@ BEGIN:
 	panic("Invalid field namespace")
 }
 
+// isRequiredTag returns whether the tag is one of the required validations, run against non-pointer struct fields
+// when enabled using WithRequiredStructEnabled.
+func isRequiredTag(tag string) bool {
+	_, ok := requiredTags[tag]
+	return ok
+}
+
+// isBytes returns whether the field is a []byte, validated as its contents by the string validations.
+func isBytes(field reflect.Value) bool {
+	return field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Uint8
+}
+
+// fieldString returns the string value of the field, or the contents of a []byte field.
+func fieldString(fl FieldLevel) string {
+	field := fl.Field()
+	if isBytes(field) {
+		return string(field.Bytes())
+	}
+	return field.String()
+}
+
+// bytesAsString returns a []byte field as a string value, so that the string validations switching on the kind
+// of the field validate its contents, and any other field unchanged.
+func bytesAsString(field reflect.Value) reflect.Value {
+	if isBytes(field) {
+		return reflect.ValueOf(string(field.Bytes()))
+	}
+	return field
+}
+
 // asInt returns the parameter as a int64
 // or panics if it can't convert
 func asInt(param string) int64 {
@@ -271,6 +540,224 @@ func asFloat(param string) float64 {
 	return i
 }
 
+// coerceStruct parses the string fields of current tagged with coerce into their target sibling fields,
+// recursing into struct and non-nil pointer to struct fields; seen guards against pointer cycles.
+func (v *Validate) coerceStruct(current reflect.Value, seen map[uintptr]struct{}) {
+	typ := current.Type()
+
+	cs, ok := v.structCache.Get(typ)
+	if !ok {
+		cs = v.extractStructCache(current, structName(typ))
+	}
+
+	for _, f := range cs.fields {
+		fv := current.Field(f.idx)
+
+		for ct := f.cTags; ct != nil; ct = ct.next {
+			if ct.tag == coerceTag {
+				v.coerceField(current, fv, f.name, ct.param)
+			}
+		}
+
+		for fv.Kind() == reflect.Ptr && !fv.IsNil() {
+			if _, ok := seen[fv.Pointer()]; ok {
+				break
+			}
+			seen[fv.Pointer()] = struct{}{}
+			fv = fv.Elem()
+		}
+
+		if fv.Kind() != reflect.Struct || fv.Type() == timeType || v.isValueStruct(fv.Type()) || !fv.CanSet() {
+			continue
+		}
+
+		v.coerceStruct(fv, seen)
+	}
+}
+
+// coerceField parses the string held by field, named name, into the numeric sibling field target of current.
+func (v *Validate) coerceField(current, field reflect.Value, name, target string) {
+	tf := current.FieldByName(target)
+	if !tf.IsValid() || !tf.CanSet() {
+		panic(fmt.Sprintf("Undefined coerce target '%s' on field '%s'", target, name))
+	}
+
+	for field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return
+		}
+		field = field.Elem()
+	}
+
+	if field.Kind() != reflect.String {
+		panic(fmt.Sprintf("Bad field type %s", field.Type()))
+	}
+
+	s := field.String()
+	if len(s) == 0 {
+		return
+	}
+
+	nt := tf.Type()
+	for nt.Kind() == reflect.Ptr {
+		nt = nt.Elem()
+	}
+
+	n := reflect.New(nt).Elem()
+
+	switch nt.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, nt.Bits())
+		if err != nil {
+			return
+		}
+		n.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, nt.Bits())
+		if err != nil {
+			return
+		}
+		n.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, nt.Bits())
+		if err != nil {
+			return
+		}
+		n.SetFloat(f)
+
+	default:
+		panic(fmt.Sprintf("Bad coerce target type %s on field '%s'", tf.Type(), name))
+	}
+
+	// allocate any pointers leading to the target's value
+	for tf.Kind() == reflect.Ptr {
+		if tf.IsNil() {
+			tf.Set(reflect.New(tf.Type().Elem()))
+		}
+		tf = tf.Elem()
+	}
+
+	tf.Set(n)
+}
+
+// valuesEqual reports whether a and b hold the same value, comparing numbers across kinds.
+func valuesEqual(a, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return false
+	}
+	if c, ok := compareNumeric(a, b); ok {
+		return c == 0
+	}
+	if a.Kind() == reflect.String && b.Kind() == reflect.String {
+		return a.String() == b.String()
+	}
+	if a.Type() != b.Type() || !a.Type().Comparable() || !a.CanInterface() || !b.CanInterface() {
+		return false
+	}
+	return a.Interface() == b.Interface()
+}
+
+// compareNumeric compares the numeric values of a and b, which may be of differing
+// numeric kinds, returning -1, 0 or 1 and false when either isn't a number.
+// Integers are compared exactly and converted to float64 only when compared to a float.
+func compareNumeric(a, b reflect.Value) (int, bool) {
+	ak, bk := numericClass(a.Kind()), numericClass(b.Kind())
+	if ak == 0 || bk == 0 {
+		return 0, false
+	}
+
+	switch {
+	case ak == reflect.Float64 || bk == reflect.Float64:
+		return compareFloat(numericAsFloat(a), numericAsFloat(b)), true
+
+	case ak == reflect.Int64 && bk == reflect.Int64:
+		return compareInt(a.Int(), b.Int()), true
+
+	case ak == reflect.Uint64 && bk == reflect.Uint64:
+		return compareUint(a.Uint(), b.Uint()), true
+
+	case ak == reflect.Int64:
+		if a.Int() < 0 {
+			return -1, true
+		}
+		return compareUint(uint64(a.Int()), b.Uint()), true
+
+	default:
+		if b.Int() < 0 {
+			return 1, true
+		}
+		return compareUint(a.Uint(), uint64(b.Int())), true
+	}
+}
+
+// numericClass returns reflect.Int64, reflect.Uint64 or reflect.Float64 for numeric
+// kinds and 0 otherwise.
+func numericClass(k reflect.Kind) reflect.Kind {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int64
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint64
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	default:
+		return 0
+	}
+}
+
+func numericAsFloat(v reflect.Value) float64 {
+	switch numericClass(v.Kind()) {
+	case reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint64:
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case b:
+		return -1
+	}
+	return 1
+}
+
 // asBool returns the parameter as a bool
 // or panics if it can't convert
 func asBool(param string) bool {
@@ -286,3 +773,82 @@ func panicIf(err error) {
 		panic(err.Error())
 	}
 }
+
+// diffStruct records in changed the struct namespaces of the fields of current differing from those of original,
+// recursing into nested structs; fields whose value is to be validated in full are recorded as true, nested structs
+// compared field by field as false. An invalid original records all fields as changed.
+func (v *Validate) diffStruct(current, original reflect.Value, prefix string, changed map[string]bool) {
+
+	typ := current.Type()
+
+	cs, ok := v.structCache.Get(typ)
+	if !ok {
+		cs = v.extractStructCache(current, structName(typ))
+	}
+
+	for _, f := range cs.fields {
+
+		fv := current.Field(f.idx)
+
+		var ov reflect.Value
+		if original.IsValid() {
+			ov = original.Field(f.idx)
+
+			if fv.CanInterface() && reflect.DeepEqual(fv.Interface(), ov.Interface()) {
+				continue
+			}
+		}
+
+		fv, ov = reflect.Indirect(fv), reflect.Indirect(ov)
+
+		// the fields of flattened embedded structs are namespaced as though declared on the parent
+		if f.anonymous && v.flattenEmbedded && v.isDiffable(fv) {
+			v.diffStruct(fv, ov, prefix, changed)
+			continue
+		}
+
+		if v.isDiffable(fv) && ov.IsValid() {
+			changed[prefix+f.name] = false
+			v.diffStruct(fv, ov, prefix+f.name+v.nsSeparator, changed)
+			continue
+		}
+
+		changed[prefix+f.name] = true
+	}
+}
+
+// isDiffable reports whether the value is a struct compared field by field by diffStruct, as opposed to as a whole.
+func (v *Validate) isDiffable(current reflect.Value) bool {
+	if !current.IsValid() || current.Kind() != reflect.Struct {
+		return false
+	}
+
+	typ := current.Type()
+	if typ == timeType || v.isValueStruct(typ) {
+		return false
+	}
+
+	_, ok := v.customFuncs[typ]
+	return !ok
+}
+
+// isChanged reports whether the struct namespace was recorded by diffStruct, or is nested within a field recorded
+// to be validated in full.
+func (v *Validate) isChanged(changed map[string]bool, ns []byte) bool {
+
+	if _, ok := changed[string(ns)]; ok {
+		return true
+	}
+
+	sep, left := v.nsSeparator, v.nsLeftBracket
+
+	for i := 1; i < len(ns); i++ {
+		if bytes.HasPrefix(ns[i:], []byte(sep)) || (len(left) > 0 && bytes.HasPrefix(ns[i:], []byte(left))) {
+			if changed[string(ns[:i])] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -16,17 +16,29 @@ type validate struct {
 	errs           ValidationErrors
 	includeExclude map[string]struct{} // reset only if StructPartial or StructExcept are called, no need otherwise
 	ffn            FilterFunc
-	slflParent     reflect.Value // StructLevel & FieldLevel
-	slCurrent      reflect.Value // StructLevel & FieldLevel
-	flField        reflect.Value // StructLevel & FieldLevel
-	cf             *cField       // StructLevel & FieldLevel
-	ct             *cTag         // StructLevel & FieldLevel
-	misc           []byte        // misc reusable
-	str1           string        // misc reusable
-	str2           string        // misc reusable
-	fldIsPointer   bool          // StructLevel & FieldLevel
+	slflParent     reflect.Value    // StructLevel & FieldLevel
+	slCurrent      reflect.Value    // StructLevel & FieldLevel
+	flField        reflect.Value    // StructLevel & FieldLevel
+	flFieldRaw     reflect.Value    // FieldLevel, the field prior to dereferencing and custom type extraction
+	cf             *cField          // StructLevel & FieldLevel
+	ct             *cTag            // StructLevel & FieldLevel
+	misc           []byte           // misc reusable
+	skipTag        string           // reset only if StructSkippingTag is called, no need otherwise
+	rules          map[string]*cTag // reset only if StructWithRules is called, no need otherwise; nil cTag skips the field
+	rootLen        int              // length of the top level struct name, including separator, within namespaces
+	str1           string           // misc reusable
+	str2           string           // misc reusable
+	customTypeErr  reflect.Type     // set when a CustomTypeFunc returned an unusable value
+	errParam       string           // set by a validation to report a more specific param than the tag's on failure
+	badCrossField  bool             // set when a cross-field reference named a field which doesn't exist, in strict mode
+	fldIsPointer   bool             // StructLevel & FieldLevel
 	isPartial      bool
 	hasExcludes    bool
+	errLimit       int                  // stop once this many errors are collected, set by Valid and StructN
+	errTag         string               // set by a validation, using ReportErrorTag, to report in place of the tag on failure
+	typeDepth      map[reflect.Type]int // nesting depth of the struct types capped using SetTypeMaxDepth being validated
+	ctx            context.Context      // FieldLevel, the context passed to the validation
+	customErrCause error                // set with customTypeErr when a CustomTypeFuncE failed
 }
 
 // parent and current will be the same the first run of validateStruct
@@ -34,16 +46,30 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 
 	cs, ok := v.v.structCache.Get(typ)
 	if !ok {
-		cs = v.v.extractStructCache(current, typ.Name())
+		cs = v.v.extractStructCache(current, structName(typ))
 	}
 
-	if len(ns) == 0 && len(cs.name) != 0 {
+	if len(ns) == 0 {
+		v.rootLen = 0
 
-		ns = append(ns, cs.name...)
-		ns = append(ns, '.')
+		if len(cs.name) != 0 {
 
-		structNs = append(structNs, cs.name...)
-		structNs = append(structNs, '.')
+			ns = append(ns, cs.name...)
+			ns = append(ns, v.v.nsSeparator...)
+
+			structNs = append(structNs, cs.name...)
+			structNs = append(structNs, v.v.nsSeparator...)
+
+			v.rootLen = len(ns)
+		}
+	}
+
+	_, capped := v.v.typeMaxDepth[typ]
+	if capped {
+		if v.typeDepth == nil {
+			v.typeDepth = make(map[reflect.Type]int)
+		}
+		v.typeDepth[typ]++
 	}
 
 	// ct is nil on top level struct, and structs as fields that have no tag info
@@ -56,7 +82,9 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 
 			f = cs.fields[i]
 
-			if v.isPartial {
+			// flattened embedded structs aren't part of the namespace so are never filtered themselves,
+			// only their fields are
+			if v.isPartial && !(f.anonymous && v.v.flattenEmbedded) {
 
 				if v.ffn != nil {
 					// used with StructFiltered
@@ -74,7 +102,22 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 				}
 			}
 
-			v.traverseField(ctx, current, current.Field(f.idx), ns, structNs, f, f.cTags)
+			if len(v.skipTag) > 0 && len(typ.Field(f.idx).Tag.Get(v.skipTag)) > 0 {
+				continue
+			}
+
+			ct := f.cTags
+
+			if v.rules != nil {
+				if rct, ok := v.rules[v.rulePath(structNs, f.name)]; ok {
+					if rct == nil {
+						continue
+					}
+					ct = rct
+				}
+			}
+
+			v.traverseField(ctx, current, current.Field(f.idx), ns, structNs, f, ct)
 		}
 	}
 
@@ -90,6 +133,40 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 
 		cs.fn(ctx, v)
 	}
+
+	// followed by the struct validating itself
+	if cs.validatable {
+
+		v.slflParent = parent
+		v.slCurrent = current
+		v.ns = ns
+		v.actualNs = structNs
+
+		v.callValidatable(ctx, current)
+	}
+
+	if capped {
+		v.typeDepth[typ]--
+	}
+}
+
+// callValidatable calls the Validate method of the struct current, preferring ValidatableCtx, using its address
+// when addressable so that methods with pointer receivers are found.
+func (v *validate) callValidatable(ctx context.Context, current reflect.Value) {
+	if current.CanAddr() {
+		current = current.Addr()
+	}
+
+	if !current.CanInterface() {
+		return
+	}
+
+	switch s := current.Interface().(type) {
+	case ValidatableCtx:
+		s.Validate(ctx, v)
+	case Validatable:
+		s.Validate(v)
+	}
 }
 
 // traverseField validates any field, be it a struct or single field, ensures it's validity and passes it along to be validated via it's tag options
@@ -97,8 +174,107 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 	var typ reflect.Type
 	var kind reflect.Kind
 
+	// stop collecting once past the limit set using SetMaxErrors, one more error than allowed marking the truncation,
+	// or once the errors requested, the first only when only validity is needed, are collected
+	if (v.v.maxErrors > 0 && len(v.errs) > v.v.maxErrors) || (v.errLimit > 0 && len(v.errs) >= v.errLimit) {
+		return
+	}
+
+	v.ctx = ctx
+
+	// skip_if and skip_unless suppress all remaining validations, so are evaluated prior to anything else
+	for ct != nil && ct.typeof == typeSkip {
+		// set Field Level fields
+		v.slflParent = parent
+		v.flField = current
+		v.flFieldRaw = current
+		v.cf = cf
+		v.ct = ct
+
+		if !v.runValidation(ctx, ct) {
+			if v.badCrossField {
+				v.str1 = string(append(ns, cf.altName...))
+
+				if v.v.hasTagNameFunc {
+					v.str2 = string(append(structNs, cf.name...))
+				} else {
+					v.str2 = v.str1
+				}
+
+				var value interface{}
+				if current.IsValid() {
+					value = current.Interface()
+				}
+
+				v.errs = append(v.errs,
+					&fieldError{
+						v:              v.v,
+						rootLen:        v.rootLen,
+						tag:            badCrossFieldTag,
+						actualTag:      badCrossFieldTag,
+						ns:             v.str1,
+						structNs:       v.str2,
+						fieldLen:       uint8(len(cf.altName)),
+						structfieldLen: uint8(len(cf.name)),
+						sensitive:      cf.sensitive,
+						value:          value,
+						param:          ct.param,
+						kind:           current.Kind(),
+						typ:            typeOf(current),
+					},
+				)
+			}
+			return
+		}
+
+		ct = ct.next
+	}
+
+	raw := current
+
+	v.customTypeErr = nil
+	v.customErrCause = nil
 	current, kind, v.fldIsPointer = v.extractTypeInternal(current, false)
 
+	if v.customTypeErr != nil {
+		param := v.customTypeErr.String()
+		if v.customErrCause != nil {
+			param = v.customErrCause.Error()
+		}
+
+		v.str1 = string(append(ns, cf.altName...))
+
+		if v.v.hasTagNameFunc {
+			v.str2 = string(append(structNs, cf.name...))
+		} else {
+			v.str2 = v.str1
+		}
+
+		v.errs = append(v.errs,
+			&fieldError{
+				v:              v.v,
+				rootLen:        v.rootLen,
+				tag:            customTypeTag,
+				actualTag:      customTypeTag,
+				ns:             v.str1,
+				structNs:       v.str2,
+				fieldLen:       uint8(len(cf.altName)),
+				structfieldLen: uint8(len(cf.name)),
+				sensitive:      cf.sensitive,
+				param:          param,
+				kind:           kind,
+				typ:            v.customTypeErr,
+			},
+		)
+		return
+	}
+
+	if v.v.defaulters != nil && current.CanSet() && current.IsZero() {
+		if fn, ok := v.v.defaulters[current.Type()]; ok {
+			fn(current)
+		}
+	}
+
 	switch kind {
 	case reflect.Ptr, reflect.Interface, reflect.Invalid:
 
@@ -121,12 +297,14 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 				v.errs = append(v.errs,
 					&fieldError{
 						v:              v.v,
+						rootLen:        v.rootLen,
 						tag:            ct.aliasTag,
 						actualTag:      ct.tag,
 						ns:             v.str1,
 						structNs:       v.str2,
 						fieldLen:       uint8(len(cf.altName)),
 						structfieldLen: uint8(len(cf.name)),
+						sensitive:      cf.sensitive,
 						param:          ct.param,
 						kind:           kind,
 					},
@@ -144,12 +322,14 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 				v.errs = append(v.errs,
 					&fieldError{
 						v:              v.v,
+						rootLen:        v.rootLen,
 						tag:            ct.aliasTag,
 						actualTag:      ct.tag,
 						ns:             v.str1,
 						structNs:       v.str2,
 						fieldLen:       uint8(len(cf.altName)),
 						structfieldLen: uint8(len(cf.name)),
+						sensitive:      cf.sensitive,
 						value:          current.Interface(),
 						param:          ct.param,
 						kind:           kind,
@@ -164,20 +344,21 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 
 		typ = current.Type()
 
-		if typ != timeType {
+		if typ != timeType && typ != regexpType && !v.v.isValueStruct(typ) {
 
 			if ct != nil {
 
 				if ct.typeof == typeStructOnly {
 					goto CONTINUE
-				} else if ct.typeof == typeIsDefault {
+				} else if ct.typeof == typeIsDefault || (v.v.requiredStructs && ct.typeof == typeDefault && isRequiredTag(ct.tag)) {
 					// set Field Level fields
 					v.slflParent = parent
 					v.flField = current
+					v.flFieldRaw = raw
 					v.cf = cf
 					v.ct = ct
 
-					if !ct.fn(ctx, v) {
+					if !v.runValidation(ctx, ct) {
 						v.str1 = string(append(ns, cf.altName...))
 
 						if v.v.hasTagNameFunc {
@@ -186,17 +367,21 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 							v.str2 = v.str1
 						}
 
+						tag, actualTag, param := v.failedTag(ct)
+
 						v.errs = append(v.errs,
 							&fieldError{
 								v:              v.v,
-								tag:            ct.aliasTag,
-								actualTag:      ct.tag,
+								rootLen:        v.rootLen,
+								tag:            tag,
+								actualTag:      actualTag,
 								ns:             v.str1,
 								structNs:       v.str2,
 								fieldLen:       uint8(len(cf.altName)),
 								structfieldLen: uint8(len(cf.name)),
+								sensitive:      cf.sensitive,
 								value:          current.Interface(),
-								param:          ct.param,
+								param:          param,
 								kind:           kind,
 								typ:            typ,
 							},
@@ -213,13 +398,43 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 			}
 
 		CONTINUE:
+			// stop descending into types nested deeper than allowed using SetTypeMaxDepth
+			if depth, ok := v.v.typeMaxDepth[typ]; ok && v.typeDepth[typ] >= depth {
+				v.str1 = string(append(ns, cf.altName...))
+
+				if v.v.hasTagNameFunc {
+					v.str2 = string(append(structNs, cf.name...))
+				} else {
+					v.str2 = v.str1
+				}
+
+				v.errs = append(v.errs,
+					&fieldError{
+						v:              v.v,
+						rootLen:        v.rootLen,
+						tag:            maxDepthTag,
+						actualTag:      maxDepthTag,
+						ns:             v.str1,
+						structNs:       v.str2,
+						fieldLen:       uint8(len(cf.altName)),
+						structfieldLen: uint8(len(cf.name)),
+						sensitive:      cf.sensitive,
+						param:          strconv.Itoa(depth),
+						kind:           kind,
+						typ:            typ,
+					},
+				)
+				return
+			}
+
 			// if len == 0 then validating using 'Var' or 'VarWithValue'
 			// Var - doesn't make much sense to do it that way, should call 'Struct', but no harm...
 			// VarWithField - this allows for validating against each field within the struct against a specific value
 			//                pretty handy in certain situations
-			if len(cf.name) > 0 {
-				ns = append(append(ns, cf.altName...), '.')
-				structNs = append(append(structNs, cf.name...), '.')
+			// when flattening, the fields of embedded structs are namespaced as though declared on the parent
+			if len(cf.name) > 0 && !(cf.anonymous && v.v.flattenEmbedded) {
+				ns = append(append(ns, cf.altName...), v.v.nsSeparator...)
+				structNs = append(append(structNs, cf.name...), v.v.nsSeparator...)
 			}
 
 			v.validateStruct(ctx, parent, current, typ, ns, structNs, ct)
@@ -227,7 +442,7 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 		}
 	}
 
-	if !ct.hasTag {
+	if ct == nil || !ct.hasTag {
 		return
 	}
 
@@ -246,6 +461,7 @@ OUTER:
 			// set Field Level fields
 			v.slflParent = parent
 			v.flField = current
+			v.flFieldRaw = raw
 			v.cf = cf
 			v.ct = ct
 
@@ -268,47 +484,35 @@ OUTER:
 			switch kind {
 			case reflect.Slice, reflect.Array:
 
-				var i64 int64
-				reusableCF := &cField{}
-
-				for i := 0; i < current.Len(); i++ {
-
-					i64 = int64(i)
-
-					v.misc = append(v.misc[0:0], cf.name...)
-					v.misc = append(v.misc, '[')
-					v.misc = strconv.AppendInt(v.misc, i64, 10)
-					v.misc = append(v.misc, ']')
-
-					reusableCF.name = string(v.misc)
-
-					if cf.namesEqual {
-						reusableCF.altName = reusableCF.name
-					} else {
+				// only allocated when there are elements, keeping empty slices free
+				if n := current.Len(); n > 0 {
+					reusableCF := &cField{sensitive: cf.sensitive}
 
-						v.misc = append(v.misc[0:0], cf.altName...)
-						v.misc = append(v.misc, '[')
-						v.misc = strconv.AppendInt(v.misc, i64, 10)
-						v.misc = append(v.misc, ']')
-
-						reusableCF.altName = string(v.misc)
+					for i := 0; i < n; i++ {
+						v.setIndexedName(reusableCF, cf, int64(i))
+						v.traverseField(ctx, parent, current.Index(i), ns, structNs, reusableCF, ct)
 					}
-					v.traverseField(ctx, parent, current.Index(i), ns, structNs, reusableCF, ct)
 				}
 
 			case reflect.Map:
 
 				var pv string
-				reusableCF := &cField{}
+				reusableCF := &cField{sensitive: cf.sensitive}
+				keyCF := &cField{}
+
+				keys := current.MapKeys()
+				if v.v.sortMapKeys {
+					sortMapKeys(keys)
+				}
 
-				for _, key := range current.MapKeys() {
+				for _, key := range keys {
 
 					pv = fmt.Sprintf("%v", key.Interface())
 
 					v.misc = append(v.misc[0:0], cf.name...)
-					v.misc = append(v.misc, '[')
+					v.misc = append(v.misc, v.v.nsLeftBracket...)
 					v.misc = append(v.misc, pv...)
-					v.misc = append(v.misc, ']')
+					v.misc = append(v.misc, v.v.nsRightBracket...)
 
 					reusableCF.name = string(v.misc)
 
@@ -316,9 +520,9 @@ OUTER:
 						reusableCF.altName = reusableCF.name
 					} else {
 						v.misc = append(v.misc[0:0], cf.altName...)
-						v.misc = append(v.misc, '[')
+						v.misc = append(v.misc, v.v.nsLeftBracket...)
 						v.misc = append(v.misc, pv...)
-						v.misc = append(v.misc, ']')
+						v.misc = append(v.misc, v.v.nsRightBracket...)
 
 						reusableCF.altName = string(v.misc)
 					}
@@ -330,14 +534,37 @@ OUTER:
 							v.traverseField(ctx, parent, current.MapIndex(key), ns, structNs, reusableCF, ct.next)
 						}
 					} else {
+						if isStructKey(key) {
+							// struct keys are validated as any other struct, their errors namespaced
+							// eg. Map[key:{1 a}].Field to tell them apart from the value's
+							keyCF.name = cf.name + v.v.nsLeftBracket + mapKeyPrefix + pv + v.v.nsRightBracket
+							keyCF.altName = cf.altName + v.v.nsLeftBracket + mapKeyPrefix + pv + v.v.nsRightBracket
+							v.traverseField(ctx, parent, key, ns, structNs, keyCF, nil)
+						}
+
 						v.traverseField(ctx, parent, current.MapIndex(key), ns, structNs, reusableCF, ct)
 					}
 				}
 
 			default:
-				// throw error, if not a slice or map then should not have gotten here
-				// bad dive tag
-				panic("dive error! can't dive on a non slice or map")
+				iter, ok := v.v.iterators[current.Type()]
+				if !ok {
+					// throw error, if not a slice, map or registered iterator then should not have gotten here
+					// bad dive tag
+					panic((&TagKindError{Tag: diveTag, Type: current.Type()}).Error())
+				}
+
+				reusableCF := &cField{sensitive: cf.sensitive}
+				next := iter(current)
+
+				for i := int64(0); ; i++ {
+					elem, ok := next()
+					if !ok {
+						break
+					}
+					v.setIndexedName(reusableCF, cf, i)
+					v.traverseField(ctx, parent, elem, ns, structNs, reusableCF, ct)
+				}
 			}
 
 			return
@@ -345,16 +572,18 @@ OUTER:
 		case typeOr:
 
 			v.misc = v.misc[0:0]
+			var badCrossField *cTag // the first of the 'or' values referencing a field which doesn't exist
 
 			for {
 
 				// set Field Level fields
 				v.slflParent = parent
 				v.flField = current
+				v.flFieldRaw = raw
 				v.cf = cf
 				v.ct = ct
 
-				if ct.fn(ctx, v) {
+				if v.runValidation(ctx, ct) {
 
 					// drain rest of the 'or' values, then continue or leave
 					for {
@@ -371,6 +600,10 @@ OUTER:
 					}
 				}
 
+				if v.badCrossField && badCrossField == nil {
+					badCrossField = ct
+				}
+
 				v.misc = append(v.misc, '|')
 				v.misc = append(v.misc, ct.tag...)
 
@@ -389,17 +622,39 @@ OUTER:
 						v.str2 = v.str1
 					}
 
-					if ct.hasAlias {
+					if badCrossField != nil {
+
+						v.errs = append(v.errs,
+							&fieldError{
+								v:              v.v,
+								rootLen:        v.rootLen,
+								tag:            badCrossFieldTag,
+								actualTag:      badCrossFieldTag,
+								ns:             v.str1,
+								structNs:       v.str2,
+								fieldLen:       uint8(len(cf.altName)),
+								structfieldLen: uint8(len(cf.name)),
+								sensitive:      cf.sensitive,
+								value:          current.Interface(),
+								param:          badCrossField.param,
+								kind:           kind,
+								typ:            typ,
+							},
+						)
+
+					} else if ct.hasAlias {
 
 						v.errs = append(v.errs,
 							&fieldError{
 								v:              v.v,
+								rootLen:        v.rootLen,
 								tag:            ct.aliasTag,
 								actualTag:      ct.actualAliasTag,
 								ns:             v.str1,
 								structNs:       v.str2,
 								fieldLen:       uint8(len(cf.altName)),
 								structfieldLen: uint8(len(cf.name)),
+								sensitive:      cf.sensitive,
 								value:          current.Interface(),
 								param:          ct.param,
 								kind:           kind,
@@ -414,12 +669,14 @@ OUTER:
 						v.errs = append(v.errs,
 							&fieldError{
 								v:              v.v,
+								rootLen:        v.rootLen,
 								tag:            tVal,
 								actualTag:      tVal,
 								ns:             v.str1,
 								structNs:       v.str2,
 								fieldLen:       uint8(len(cf.altName)),
 								structfieldLen: uint8(len(cf.name)),
+								sensitive:      cf.sensitive,
 								value:          current.Interface(),
 								param:          ct.param,
 								kind:           kind,
@@ -439,10 +696,11 @@ OUTER:
 			// set Field Level fields
 			v.slflParent = parent
 			v.flField = current
+			v.flFieldRaw = raw
 			v.cf = cf
 			v.ct = ct
 
-			if !ct.fn(ctx, v) {
+			if !v.runValidation(ctx, ct) {
 
 				v.str1 = string(append(ns, cf.altName...))
 
@@ -452,17 +710,21 @@ OUTER:
 					v.str2 = v.str1
 				}
 
+				tag, actualTag, param := v.failedTag(ct)
+
 				v.errs = append(v.errs,
 					&fieldError{
 						v:              v.v,
-						tag:            ct.aliasTag,
-						actualTag:      ct.tag,
+						rootLen:        v.rootLen,
+						tag:            tag,
+						actualTag:      actualTag,
 						ns:             v.str1,
 						structNs:       v.str2,
 						fieldLen:       uint8(len(cf.altName)),
 						structfieldLen: uint8(len(cf.name)),
+						sensitive:      cf.sensitive,
 						value:          current.Interface(),
-						param:          ct.param,
+						param:          param,
 						kind:           kind,
 						typ:            typ,
 					},
@@ -475,3 +737,72 @@ OUTER:
 	}
 
 }
+
+// runValidation runs the validation ct against the field level fields set, reporting whether it passed; one
+// referencing a field which doesn't exist, using SetStrictCrossField, fails whatever it returns.
+func (v *validate) runValidation(ctx context.Context, ct *cTag) bool {
+	v.errParam = ""
+	v.errTag = ""
+	v.badCrossField = false
+
+	return ct.fn(ctx, v) && !v.badCrossField
+}
+
+// failedTag returns the tag, actual tag and param to report for the validation ct failed by runValidation; the
+// validation may override the param and tag, and an unresolvable cross-field reference is reported as such.
+func (v *validate) failedTag(ct *cTag) (tag, actualTag, param string) {
+	tag, actualTag, param = ct.aliasTag, ct.tag, ct.param
+
+	if len(v.errParam) > 0 {
+		param = v.errParam
+	}
+
+	if len(v.errTag) > 0 {
+		tag, actualTag = v.errTag, v.errTag
+	}
+
+	if v.badCrossField {
+		tag, actualTag = badCrossFieldTag, badCrossFieldTag
+	}
+
+	return
+}
+
+// setIndexedName names reusableCF as the element at index i of the field cf eg. Items[0].
+func (v *validate) setIndexedName(reusableCF *cField, cf *cField, i int64) {
+	v.misc = append(v.misc[0:0], cf.name...)
+	v.misc = append(v.misc, v.v.nsLeftBracket...)
+	v.misc = strconv.AppendInt(v.misc, i, 10)
+	v.misc = append(v.misc, v.v.nsRightBracket...)
+
+	reusableCF.name = string(v.misc)
+
+	if cf.namesEqual {
+		reusableCF.altName = reusableCF.name
+		return
+	}
+
+	v.misc = append(v.misc[0:0], cf.altName...)
+	v.misc = append(v.misc, v.v.nsLeftBracket...)
+	v.misc = strconv.AppendInt(v.misc, i, 10)
+	v.misc = append(v.misc, v.v.nsRightBracket...)
+
+	reusableCF.altName = string(v.misc)
+}
+
+// cappedErrs returns the errors collected, truncated to the limit set using SetMaxErrors followed by a '_truncated'
+// error when more were collected.
+func (v *validate) cappedErrs() ValidationErrors {
+	if v.v.maxErrors <= 0 || len(v.errs) <= v.v.maxErrors {
+		return v.errs
+	}
+
+	errs := v.errs[:v.v.maxErrors:v.v.maxErrors]
+
+	return append(errs, &fieldError{
+		v:         v.v,
+		tag:       truncatedTag,
+		actualTag: truncatedTag,
+		param:     strconv.Itoa(v.v.maxErrors),
+	})
+}
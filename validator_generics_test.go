@@ -0,0 +1,65 @@
+//go:build go1.18
+// +build go1.18
+
+package validator
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/go-playground/assert/v2"
+)
+
+type genericUser struct {
+	Name string `validate:"required"`
+}
+
+type genericOrder struct {
+	ID    int           `validate:"gt=0"`
+	Items []genericUser `validate:"required,dive"`
+}
+
+type genericResponse[T any] struct {
+	Code int `validate:"gte=100,lt=600"`
+	Data T   `validate:"required"`
+}
+
+func TestGenericStructValidation(t *testing.T) {
+	validate := New()
+
+	errs := validate.Struct(genericResponse[genericUser]{Code: 200, Data: genericUser{Name: "joeybloggs"}})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(genericResponse[genericUser]{Code: 200, Data: genericUser{}})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "genericResponse.Data.Name", "genericResponse.Data.Name", "Name", "Name", "required")
+
+	errs = validate.Struct(genericResponse[genericOrder]{Code: 200, Data: genericOrder{ID: 1, Items: []genericUser{{Name: "a"}}}})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(genericResponse[genericOrder]{Code: 99, Data: genericOrder{Items: []genericUser{{}}}})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "genericResponse.Code", "genericResponse.Code", "Code", "Code", "gte")
+	AssertError(t, errs, "genericResponse.Data.ID", "genericResponse.Data.ID", "ID", "ID", "gt")
+	AssertError(t, errs, "genericResponse.Data.Items[0].Name", "genericResponse.Data.Items[0].Name", "Name", "Name", "required")
+
+	// each instantiation must be cached separately
+	_, ok := validate.structCache.Get(reflect.TypeOf(genericResponse[genericUser]{}))
+	Equal(t, ok, true)
+	_, ok = validate.structCache.Get(reflect.TypeOf(genericResponse[genericOrder]{}))
+	Equal(t, ok, true)
+
+	errs = validate.Struct(&genericResponse[*genericUser]{Code: 200})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "genericResponse.Data", "genericResponse.Data", "Data", "Data", "required")
+
+	errs = validate.StructPartial(genericResponse[genericOrder]{Code: 99}, "Data.ID")
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "genericResponse.Data.ID", "genericResponse.Data.ID", "ID", "ID", "gt")
+
+	errs = validate.StructExcept(genericResponse[genericOrder]{Code: 99, Data: genericOrder{ID: 1, Items: []genericUser{{Name: "a"}}}}, "Code")
+	Equal(t, errs, nil)
+}
@@ -4,9 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
+	"net/url"
 	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	ut "github.com/go-playground/universal-translator"
@@ -28,16 +35,27 @@ const (
 	requiredWithTag       = "required_with"
 	requiredWithAllTag    = "required_with_all"
 	requiredIfTag         = "required_if"
+	requiredIfAnyTag      = "required_if_any"
 	requiredUnlessTag     = "required_unless"
+	requiredOneOfTag      = "required_one_of"
+	requiredExactlyOneTag = "required_exactly_one_of"
 	excludedWithoutAllTag = "excluded_without_all"
 	excludedWithoutTag    = "excluded_without"
 	excludedWithTag       = "excluded_with"
 	excludedWithAllTag    = "excluded_with_all"
+	skipIfTag             = "skip_if"
+	skipUnlessTag         = "skip_unless"
+	mapKeyPrefix          = "key:"
 	skipValidationTag     = "-"
 	diveTag               = "dive"
 	keysTag               = "keys"
 	endKeysTag            = "endkeys"
 	requiredTag           = "required"
+	customTypeTag         = "_customtype"
+	badCrossFieldTag      = "_badcrossfield"
+	truncatedTag          = "_truncated"
+	maxDepthTag           = "_maxdepth"
+	coerceTag             = "coerce"
 	namespaceSeparator    = "."
 	leftBracket           = "["
 	rightBracket          = "]"
@@ -49,8 +67,14 @@ const (
 var (
 	timeDurationType = reflect.TypeOf(time.Duration(0))
 	timeType         = reflect.TypeOf(time.Time{})
+	reflectValueType = reflect.TypeOf(reflect.Value{})
 
 	defaultCField = &cField{namesEqual: true}
+
+	// stdlibOpaqueTypes are the standard library struct types registered as opaque by WithStdlibOpaqueTypes
+	stdlibOpaqueTypes = []interface{}{
+		time.Location{}, url.URL{}, big.Int{}, big.Float{}, big.Rat{}, regexp.Regexp{},
+	}
 )
 
 // FilterFunc is the type used to filter fields using
@@ -64,6 +88,24 @@ type FilterFunc func(ns []byte) bool
 // example Valuer from sql drive see https://golang.org/src/database/sql/driver/types.go?s=1210:1293#L29
 type CustomTypeFunc func(field reflect.Value) interface{}
 
+// CustomTypeFuncE is a CustomTypeFunc which may fail to extract the value to be validated, eg. of a malformed
+// wrapper, returning an error reported as a '_customtype' error of the field.
+type CustomTypeFuncE func(field reflect.Value) (interface{}, error)
+
+// IteratorFunc returns a function yielding the elements of a custom collection type, one per call, until it
+// returns false; allowing dive to walk collections other than slices, arrays and maps.
+type IteratorFunc func(collection reflect.Value) (next func() (reflect.Value, bool))
+
+// ZeroFunc reports whether the value of a type registered using RegisterZeroFunc is logically empty.
+type ZeroFunc func(field reflect.Value) bool
+
+// Rule describes a single validation of a field's tag chain, as returned by StructRules.
+type Rule struct {
+	Tag   string // the validation tag, aliases being expanded to the tags they stand for
+	Param string // the tag's param, if any
+	Or    bool   // whether the rule is alternated, using '|', with the rule following it
+}
+
 // TagNameFunc allows for adding of a custom tag name parser
 type TagNameFunc func(field reflect.StructField) string
 
@@ -75,21 +117,50 @@ type internalValidationFuncWrapper struct {
 // Validate contains the validator settings and cache
 type Validate struct {
 	tagName          string
+	extraTagNames    []string
 	pool             *sync.Pool
 	hasCustomFuncs   bool
 	hasTagNameFunc   bool
 	tagNameFunc      TagNameFunc
 	structLevelFuncs map[reflect.Type]StructLevelFuncCtx
 	customFuncs      map[reflect.Type]CustomTypeFunc
+	iterators        map[reflect.Type]IteratorFunc
+	zeroFuncs        map[reflect.Type]ZeroFunc
+	dynamicSets      map[string]func() map[string]struct{}
+	defaulters       map[reflect.Type]func(reflect.Value)
+	opaqueTypes      map[reflect.Type]struct{}
+	typeMaxDepth     map[reflect.Type]int
+	structRules      map[reflect.Type]map[string]string // map[<struct>]map[<field>]<tag>
 	aliases          map[string]string
 	validations      map[string]internalValidationFuncWrapper
 	transTagFunc     map[ut.Translator]map[string]TranslationFunc // map[<locale>]map[<tag>]TranslationFunc
+	errorCodes       map[string]string                            // map[<tag>]<code>
+	defaultFieldTag  string
+	sensitiveTag     string
+	flattenEmbedded  bool
+	strictCrossField bool
+	strictTagKinds   bool
+	omitTopNs        bool
+	stringerLength   bool
+	emptyStringAsNil bool
+	maxErrors        int
+	requiredStructs  bool
+	sortMapKeys      bool
+	requireTags      bool
+	nowFunc          atomic.Value // func() time.Time
+	requireTagsTypes map[reflect.Type]struct{}
+	nsSeparator      string
+	nsLeftBracket    string
+	nsRightBracket   string
+	nsReplacer       *strings.Replacer // nil when using the default separator and brackets
 	tagCache         *tagCache
 	structCache      *structCache
 }
 
-// New returns a new instance of 'validate' with sane defaults.
-func New() *Validate {
+// New returns a new instance of 'validate' with sane defaults, applying any options in order.
+//
+//	validate := validator.New(validator.WithTagName("binding"), validator.WithRequiredStructEnabled())
+func New(options ...Option) *Validate {
 
 	tc := new(tagCache)
 	tc.m.Store(make(map[string]*cTag))
@@ -98,13 +169,18 @@ func New() *Validate {
 	sc.m.Store(make(map[reflect.Type]*cStruct))
 
 	v := &Validate{
-		tagName:     defaultTagName,
-		aliases:     make(map[string]string, len(bakedInAliases)),
-		validations: make(map[string]internalValidationFuncWrapper, len(bakedInValidators)),
-		tagCache:    tc,
-		structCache: sc,
+		tagName:        defaultTagName,
+		aliases:        make(map[string]string, len(bakedInAliases)),
+		validations:    make(map[string]internalValidationFuncWrapper, len(bakedInValidators)),
+		tagCache:       tc,
+		structCache:    sc,
+		nsSeparator:    namespaceSeparator,
+		nsLeftBracket:  leftBracket,
+		nsRightBracket: rightBracket,
 	}
 
+	v.nowFunc.Store(time.Now)
+
 	// must copy alias validators for separate validations to be used in each validator instance
 	for k, val := range bakedInAliases {
 		v.RegisterAlias(k, val)
@@ -115,8 +191,8 @@ func New() *Validate {
 
 		switch k {
 		// these require that even if the value is nil that the validation should run, omitempty still overrides this behaviour
-		case requiredIfTag, requiredUnlessTag, requiredWithTag, requiredWithAllTag, requiredWithoutTag, requiredWithoutAllTag,
-			excludedWithTag, excludedWithAllTag, excludedWithoutTag, excludedWithoutAllTag:
+		case requiredIfTag, requiredIfAnyTag, requiredUnlessTag, requiredWithTag, requiredWithAllTag, requiredWithoutTag, requiredWithoutAllTag,
+			requiredOneOfTag, requiredExactlyOneTag, excludedWithTag, excludedWithAllTag, excludedWithoutTag, excludedWithoutAllTag, skipIfTag, skipUnlessTag:
 			_ = v.registerValidation(k, wrapFunc(val), true, true)
 		default:
 			// no need to error check here, baked in will always be valid
@@ -135,6 +211,10 @@ func New() *Validate {
 		},
 	}
 
+	for _, opt := range options {
+		opt(v)
+	}
+
 	return v
 }
 
@@ -143,6 +223,207 @@ func (v *Validate) SetTagName(name string) {
 	v.tagName = name
 }
 
+// AddTagName adds an additional tag name whose rules are merged with those of the primary tag, set using
+// SetTagName, eg. a legacy 'binding' tag while migrating to 'validate'. The rule chains are concatenated,
+// primary first then the additional tags in the order added; so a field is skipped only when its primary tag
+// is '-', an additional tag of '-' is ignored and tags only honoured at the start of a chain, such as
+// omitempty, must be in the first non-empty one. Rules are not deduplicated, both run when repeated.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) AddTagName(name string) {
+	if len(name) == 0 || name == v.tagName {
+		return
+	}
+	for _, n := range v.extraTagNames {
+		if n == name {
+			return
+		}
+	}
+	v.extraTagNames = append(v.extraTagNames, name)
+}
+
+// SetDefaultFieldTag sets the validation tag applied to any exported field lacking a validation tag
+// eg. SetDefaultFieldTag("required") so that forgetting to tag a field fails closed. Fields opt out
+// using the skip tag '-' and an empty tag restores the default of not validating untagged fields.
+//
+// Embedded, anonymous, struct fields are not themselves given the default tag, however the default
+// is applied to their untagged fields in turn as with any other struct.
+//
+// NOTE: this method is not thread-safe it is intended that it be set prior to any validation
+func (v *Validate) SetDefaultFieldTag(tag string) {
+	v.defaultFieldTag = tag
+}
+
+// SetFlattenEmbedded sets whether the fields of embedded, anonymous, structs are namespaced as though
+// declared on the embedding struct, matching the promotion of fields by encoding/json, eg. User.ID rather
+// than the default of User.BaseModel.ID. Errors of the embedded field itself, eg. required on an embedded
+// pointer, remain namespaced by the embedded type's name. StructPartial, StructExcept and StructFiltered
+// match against the flattened namespaces.
+//
+// NOTE: this method is not thread-safe it is intended that it be set prior to any validation
+func (v *Validate) SetFlattenEmbedded(flatten bool) {
+	v.flattenEmbedded = flatten
+}
+
+// SetStrictCrossField sets whether a cross-field reference, such as eqfield=Password, naming a field that
+// doesn't exist on the struct is reported as an error with the tag '_badcrossfield', and the reference as
+// its param, rather than the validation silently passing or failing. This catches typos in references;
+// fields which exist but can't be reached, eg. through a nil pointer, are handled by the validation as usual.
+//
+// NOTE: this method is not thread-safe it is intended that it be set prior to any validation
+func (v *Validate) SetStrictCrossField(strict bool) {
+	v.strictCrossField = strict
+}
+
+// SetStrictTagKinds enables checking the validation tag of each struct field, using ValidateTagForType, when the
+// struct's type is first validated; panicking when a built-in validation is applied to a kind it doesn't support,
+// eg. min on a bool, rather than it silently passing, failing or panicking only once reached.
+//
+// NOTE: this method is not thread-safe it is intended that it be set prior to any validation
+func (v *Validate) SetStrictTagKinds(strict bool) {
+	v.strictTagKinds = strict
+}
+
+// ValidateTagForType checks the validation tag could be applied to a value of type t, returning an error for
+// invalid tags, eg. an undefined validation, and a TagKindError when a built-in validation doesn't support the
+// kind of value it would be run against, eg. email on an int, or dive is applied to a type which isn't a slice,
+// array, map or registered iterator. Pointers are dereferenced and dives followed into element and map key types
+// as when validating.
+//
+// Validations registered using RegisterValidation are assumed to support any type, as are all validations once
+// the kind is only known while validating, eg. of an interface or a type registered using RegisterCustomTypeFunc.
+//
+// It returns InvalidValidationError for a nil t.
+func (v *Validate) ValidateTagForType(tag string, t reflect.Type) error {
+
+	if t == nil {
+		return &InvalidValidationError{Type: t}
+	}
+
+	ct, err := v.parseTagErr(tag)
+	if err != nil {
+		return err
+	}
+
+	return v.checkTagKinds(ct, t, true)
+}
+
+// RegisterStringerLength opts in to len, min and max validating the length, in runes, of the String() output of
+// values implementing fmt.Stringer, eg. enums, rather than their numeric value. Only types without a length or
+// comparison of their own are affected; strings, slices, arrays, maps, time.Time and time.Duration are not.
+// The tags of struct fields only run against struct types registered using RegisterOpaqueType.
+//
+// NOTE: this method is not thread-safe it is intended that it be set prior to any validation
+func (v *Validate) RegisterStringerLength() {
+	v.stringerLength = true
+}
+
+// SetMaxErrors caps the number of errors collected validating a single value, bounding the size of the errors
+// returned, and the work done, for adversarial input eg. a slice of thousands of invalid elements. Once more
+// than n errors have been collected validation stops, returning the first n errors followed by an error with
+// the tag '_truncated', and n as its param, marking that others were dropped. n <= 0, the default, collects
+// all errors.
+//
+// NOTE: this method is not thread-safe it is intended that it be set prior to any validation
+func (v *Validate) SetMaxErrors(n int) {
+	v.maxErrors = n
+}
+
+// SetTypeMaxDepth caps how deeply structs of the sample's type may nest within one another, eg. a recursive tree
+// type, counting the outermost as depth 1. A field holding the type beyond the depth isn't validated, instead
+// reporting an error with the tag '_maxdepth' and depth as its param. depth <= 0 removes the cap. A pointer
+// sample caps the type pointed to.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) SetTypeMaxDepth(sample interface{}, depth int) {
+
+	typ := reflect.TypeOf(sample)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ == nil || typ.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("SetTypeMaxDepth requires a struct sample, got %T", sample))
+	}
+
+	if depth <= 0 {
+		delete(v.typeMaxDepth, typ)
+		return
+	}
+
+	if v.typeMaxDepth == nil {
+		v.typeMaxDepth = make(map[reflect.Type]int)
+	}
+
+	v.typeMaxDepth[typ] = depth
+}
+
+// SetSensitiveTag sets the struct tag marking fields as sensitive, eg. SetSensitiveTag("sensitive") and
+// `sensitive:"true"`, whose values FieldError.ValueString redacts so secrets don't leak into error
+// messages. Any non-empty value marks the field; elements of a sensitive slice or map are redacted too.
+// An empty tag, the default, marks no field as sensitive.
+//
+// NOTE: this method is not thread-safe it is intended that it be set prior to any validation
+func (v *Validate) SetSensitiveTag(tag string) {
+	v.sensitiveTag = tag
+}
+
+// SetNowFunc sets the clock used by the time based baked in validations, such as gt, lt, future and past
+// on a time.Time, in place of time.Now; allowing deterministic tests or the use of a logical clock. A nil
+// fn restores the default of time.Now.
+//
+// It is safe to call concurrently with validation.
+func (v *Validate) SetNowFunc(fn func() time.Time) {
+	if fn == nil {
+		fn = time.Now
+	}
+	v.nowFunc.Store(fn)
+}
+
+// now returns the current time according to the clock set using SetNowFunc.
+func (v *Validate) now() time.Time {
+	return v.nowFunc.Load().(func() time.Time)()
+}
+
+// SetRequireAllFieldsHaveTags sets whether validating a struct requires every exported field to have a
+// validation tag; a field is explicitly left unvalidated using the skip tag '-' or an empty tag. When
+// enabled, validating a struct with untagged fields returns a MissingTagsError, listing them, instead of
+// being validated. It is intended as a development time safety net against forgotten fields.
+//
+// Nested struct and pointer to struct fields are checked too, as long as they are declared in the same
+// package as the struct being validated; types from other, eg. third-party, packages are not recursed into
+// as their tags can't be changed. Use RegisterRequireAllFieldsHaveTags to opt in individual structs.
+//
+// NOTE: this method is not thread-safe it is intended that it be set prior to any validation
+func (v *Validate) SetRequireAllFieldsHaveTags(require bool) {
+	v.requireTags = require
+}
+
+// RegisterRequireAllFieldsHaveTags requires every exported field of the provided struct types to have a
+// validation tag, as SetRequireAllFieldsHaveTags does for all structs, whether validated directly or
+// nested within another struct.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterRequireAllFieldsHaveTags(types ...interface{}) {
+	if v.requireTagsTypes == nil {
+		v.requireTagsTypes = make(map[reflect.Type]struct{})
+	}
+
+	for _, t := range types {
+		typ := reflect.TypeOf(t)
+
+		for typ != nil && typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		if typ == nil || typ.Kind() != reflect.Struct {
+			panic(fmt.Sprintf("RegisterRequireAllFieldsHaveTags requires a struct, got %T", t))
+		}
+
+		v.requireTagsTypes[typ] = struct{}{}
+	}
+}
+
 // ValidateMapCtx validates a map using a map of validation rules and allows passing of contextual
 // validation validation information via context.Context.
 func (v Validate) ValidateMapCtx(ctx context.Context, data map[string]interface{}, rules map[string]interface{}) map[string]interface{} {
@@ -186,6 +467,16 @@ func (v *Validate) RegisterTagNameFunc(fn TagNameFunc) {
 	v.hasTagNameFunc = true
 }
 
+// WithValue returns a copy of ctx carrying the value under key, a convenience for passing request scoped data,
+// such as a tenant ID, to validations using VarCtx, StructCtx and the like; the validations retrieve it using
+// FieldLevel.ContextValue. A nil ctx is treated as context.Background().
+func (v *Validate) WithValue(ctx context.Context, key, val interface{}) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, key, val)
+}
+
 // RegisterValidation adds a validation with the given tag
 //
 // NOTES:
@@ -205,6 +496,54 @@ func (v *Validate) RegisterValidationCtx(tag string, fn FuncCtx, callValidationE
 	return v.registerValidation(tag, fn, false, nilCheckable)
 }
 
+// RegisterValidationSkipOnEmpty adds a validation with the given tag, as RegisterValidation does, which
+// passes without being called when the field is empty, ie. its zero value or a nil pointer, slice or map,
+// as determined by the required tag; saving custom validations from each re-implementing the check, as
+// baked in validations like email behave when combined with omitempty.
+//
+// NOTES:
+// - if the key already exists, the previous validation function will be replaced.
+// - this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterValidationSkipOnEmpty(tag string, fn Func) error {
+	return v.RegisterValidationSkipOnEmptyCtx(tag, wrapFunc(fn))
+}
+
+// RegisterValidationSkipOnEmptyCtx does the same as RegisterValidationSkipOnEmpty on accepts a FuncCtx
+// validation allowing context.Context validation support.
+func (v *Validate) RegisterValidationSkipOnEmptyCtx(tag string, fn FuncCtx) error {
+	if fn == nil {
+		return v.registerValidation(tag, fn, false, false)
+	}
+
+	return v.registerValidation(tag, func(ctx context.Context, fl FieldLevel) bool {
+		if !hasValue(fl) {
+			return true
+		}
+		return fn(ctx, fl)
+	}, false, true)
+}
+
+// RegisterValidationMap adds all validations in the map, keyed by tag, returning the first
+// error encountered.
+//
+// NOTES:
+// - validations are registered in sorted tag order so the error returned is deterministic.
+// - this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterValidationMap(m map[string]Func) error {
+	tags := make([]string, 0, len(m))
+	for tag := range m {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	for _, tag := range tags {
+		if err := v.RegisterValidation(tag, m[tag]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (v *Validate) registerValidation(tag string, fn FuncCtx, bakedIn bool, nilCheckable bool) error {
 	if len(tag) == 0 {
 		return errors.New("Function Key cannot be empty")
@@ -222,6 +561,77 @@ func (v *Validate) registerValidation(tag string, fn FuncCtx, bakedIn bool, nilC
 	return nil
 }
 
+// DeregisterValidation removes the validation registered with the given tag, returning whether it was
+// removed; after which the tag is undefined, panicking when used as any other undefined tag would.
+//
+// Baked in validations, including those overridden using RegisterValidation, are never removed and
+// false is returned, use ForceDeregisterValidation to remove them.
+//
+// NOTES:
+// - previously parsed tags and structs are evicted from the caches so the removal takes effect.
+// - this method is not thread-safe it is intended that it not be called concurrently with validation
+func (v *Validate) DeregisterValidation(tag string) bool {
+	if _, bakedIn := bakedInValidators[tag]; bakedIn {
+		return false
+	}
+
+	return v.ForceDeregisterValidation(tag)
+}
+
+// ForceDeregisterValidation removes the validation registered with the given tag, baked in or not,
+// returning whether it was removed.
+//
+// NOTES:
+// - previously parsed tags and structs are evicted from the caches so the removal takes effect.
+// - this method is not thread-safe it is intended that it not be called concurrently with validation
+func (v *Validate) ForceDeregisterValidation(tag string) bool {
+	if _, ok := v.validations[tag]; !ok {
+		return false
+	}
+
+	delete(v.validations, tag)
+	v.resetCaches()
+	return true
+}
+
+// DeregisterAlias removes the alias, returning whether it was removed. Baked in aliases, such as iscolor,
+// are never removed and false is returned, use ForceDeregisterAlias to remove them.
+//
+// NOTES:
+// - previously parsed tags and structs are evicted from the caches so the removal takes effect.
+// - this method is not thread-safe it is intended that it not be called concurrently with validation
+func (v *Validate) DeregisterAlias(alias string) bool {
+	if _, bakedIn := bakedInAliases[alias]; bakedIn {
+		return false
+	}
+
+	return v.ForceDeregisterAlias(alias)
+}
+
+// ForceDeregisterAlias removes the alias, baked in or not, returning whether it was removed.
+//
+// NOTES:
+// - previously parsed tags and structs are evicted from the caches so the removal takes effect.
+// - this method is not thread-safe it is intended that it not be called concurrently with validation
+func (v *Validate) ForceDeregisterAlias(alias string) bool {
+	if _, ok := v.aliases[alias]; !ok {
+		return false
+	}
+
+	delete(v.aliases, alias)
+	v.resetCaches()
+	return true
+}
+
+// resetCaches evicts all parsed tags and structs, which hold onto the validations they were parsed with.
+func (v *Validate) resetCaches() {
+	v.tagCache.lock.Lock()
+	v.tagCache.m.Store(make(map[string]*cTag))
+	v.tagCache.lock.Unlock()
+
+	v.structCache.reset()
+}
+
 // RegisterAlias registers a mapping of a single validation tag that
 // defines a common or complex set of validation(s) to simplify adding validation
 // to structs.
@@ -267,8 +677,57 @@ func (v *Validate) RegisterStructValidationCtx(fn StructLevelFuncCtx, types ...i
 	}
 }
 
+// RegisterStructValidationMapRules registers validation rules, a map of struct field name to tag, against
+// a number of struct types, allowing rules to be declared at runtime eg. loaded from configuration.
+//
+// A rule takes precedence over, replacing, the field's struct tag and also applies to fields lacking one;
+// to augment a struct tag the rule must repeat it. Field names are the actual Go names of the struct's
+// fields, not those of a TagNameFunc nor nested namespaces, and field params of cross field validations,
+// such as eqfield=Password, resolve exactly as they would within the struct tag. Registering rules again
+// for a type replaces them.
+//
+// It panics when a rule names a field the struct doesn't have.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterStructValidationMapRules(rules map[string]string, types ...interface{}) {
+
+	if v.structRules == nil {
+		v.structRules = make(map[reflect.Type]map[string]string)
+	}
+
+	for _, t := range types {
+		typ := reflect.TypeOf(t)
+
+		if typ != nil && typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		if typ == nil || typ.Kind() != reflect.Struct {
+			panic(fmt.Sprintf("RegisterStructValidationMapRules requires a struct, got %T", t))
+		}
+
+		for field := range rules {
+			// only the struct's own fields, not those promoted from embedded structs
+			if f, ok := typ.FieldByName(field); !ok || len(f.Index) != 1 {
+				panic(fmt.Sprintf("Field '%s' not found on struct %s", field, typ))
+			}
+		}
+
+		m := make(map[string]string, len(rules))
+		for field, tag := range rules {
+			m[field] = tag
+		}
+
+		v.structRules[typ] = m
+	}
+}
+
 // RegisterCustomTypeFunc registers a CustomTypeFunc against a number of types
 //
+// The CustomTypeFunc must return the underlying value to validate, eg. a string or int, not a reflect.Value
+// nor a value of the same type it was given; doing so reports a '_customtype' error for the field, whose
+// Param() and Type() identify the offending type, instead of validating a bogus value.
+//
 // NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
 func (v *Validate) RegisterCustomTypeFunc(fn CustomTypeFunc, types ...interface{}) {
 
@@ -283,119 +742,628 @@ func (v *Validate) RegisterCustomTypeFunc(fn CustomTypeFunc, types ...interface{
 	v.hasCustomFuncs = true
 }
 
-// RegisterTranslation registers translations against the provided tag.
-func (v *Validate) RegisterTranslation(tag string, trans ut.Translator, registerFn RegisterTranslationsFunc, translationFn TranslationFunc) (err error) {
+// RegisterCustomTypeFuncE registers a CustomTypeFuncE against a number of types, as RegisterCustomTypeFunc does,
+// allowing the extraction of the value to validate to fail. An error returned is reported as a '_customtype' error
+// for the field, whose Param() is the error's message and Type() the field's type, in place of validating it.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterCustomTypeFuncE(fn CustomTypeFuncE, types ...interface{}) {
+	v.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		val, err := fn(field)
+		if err != nil {
+			return customTypeFailure{err: err}
+		}
+		return val
+	}, types...)
+}
 
-	if v.transTagFunc == nil {
-		v.transTagFunc = make(map[ut.Translator]map[string]TranslationFunc)
-	}
+// RegisterIterator registers an IteratorFunc allowing dive to walk the elements of the sample's type, a custom
+// collection such as a linked list; the elements being namespaced by a running index as for slices. Pointer
+// samples register the type pointed to, as fields are dereferenced before being validated.
+//
+// Registered struct types are treated as collections, their validation tags being run against them as for
+// slices, rather than being descended into.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterIterator(sample interface{}, iter IteratorFunc) {
 
-	if err = registerFn(trans); err != nil {
-		return
+	if v.iterators == nil {
+		v.iterators = make(map[reflect.Type]IteratorFunc)
 	}
 
-	m, ok := v.transTagFunc[trans]
-	if !ok {
-		m = make(map[string]TranslationFunc)
-		v.transTagFunc[trans] = m
+	typ := reflect.TypeOf(sample)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
 	}
 
-	m[tag] = translationFn
-
-	return
+	v.iterators[typ] = iter
 }
 
-// Struct validates a structs exposed fields, and automatically validates nested structs, unless otherwise specified.
+// RegisterZeroFunc registers a ZeroFunc used in place of the zero value check of reflect to determine whether
+// a value of the sample's type is empty, eg. so that a decimal type's 0.00 is empty even though its struct
+// holds non-zero fields. It's used by required, omitempty and the other validations checking whether the
+// field itself has a value, whereas non-nil pointers to the type always have a value. Pointer samples register
+// the type pointed to, as fields are dereferenced before being validated.
 //
-// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
-// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
-func (v *Validate) Struct(s interface{}) error {
-	return v.StructCtx(context.Background(), s)
-}
-
-// StructCtx validates a structs exposed fields, and automatically validates nested structs, unless otherwise specified
-// and also allows passing of context.Context for contextual validation information.
+// Registered struct types are treated as values, their validation tags being run against them, rather than
+// being descended into.
 //
-// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
-// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
-func (v *Validate) StructCtx(ctx context.Context, s interface{}) (err error) {
-
-	val := reflect.ValueOf(s)
-	top := val
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterZeroFunc(sample interface{}, isZero ZeroFunc) {
 
-	if val.Kind() == reflect.Ptr && !val.IsNil() {
-		val = val.Elem()
+	if v.zeroFuncs == nil {
+		v.zeroFuncs = make(map[reflect.Type]ZeroFunc)
 	}
 
-	if val.Kind() != reflect.Struct || val.Type() == timeType {
-		return &InvalidValidationError{Type: reflect.TypeOf(s)}
+	typ := reflect.TypeOf(sample)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
 	}
 
-	// good to validate
-	vd := v.pool.Get().(*validate)
-	vd.top = top
-	vd.isPartial = false
-	// vd.hasExcludes = false // only need to reset in StructPartial and StructExcept
+	v.zeroFuncs[typ] = isZero
+}
 
-	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+// RegisterDynamicSet registers a provider of the set of allowed values named name, validated against using the
+// inset tag eg. inset=name; allowing oneof style checks against live configuration such as feature flags.
+//
+// The provider is called on every validation using the set and so must be safe for concurrent use, eg. by
+// returning a snapshot held in an atomic.Value which is replaced, not mutated, when the set changes.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterDynamicSet(name string, provider func() map[string]struct{}) {
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
+	if len(name) == 0 {
+		panic("RegisterDynamicSet: name cannot be empty")
 	}
 
-	v.pool.Put(vd)
+	if provider == nil {
+		panic("RegisterDynamicSet: provider cannot be nil")
+	}
 
-	return
+	if v.dynamicSets == nil {
+		v.dynamicSets = make(map[string]func() map[string]struct{})
+	}
+
+	v.dynamicSets[name] = provider
 }
 
-// StructFiltered validates a structs exposed fields, that pass the FilterFunc check and automatically validates
-// nested structs, unless otherwise specified.
+// RegisterDefaulter registers a func setting the default value of fields of the sample's type, called with the
+// field before it's validated whenever it holds the zero value so that its validation tags see the default.
 //
-// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
-// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
-func (v *Validate) StructFiltered(s interface{}, fn FilterFunc) error {
-	return v.StructFilteredCtx(context.Background(), s, fn)
+// WARNING: this mutates the value being validated, which is opt-in by registering a defaulter, and requires it
+// be addressable eg. by passing a pointer to Struct; values which can't be set, such as a struct passed by value,
+// a value passed to Var or one returned by a CustomTypeFunc, are validated as is.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterDefaulter(sample interface{}, fn func(field reflect.Value)) {
+
+	if v.defaulters == nil {
+		v.defaulters = make(map[reflect.Type]func(reflect.Value))
+	}
+
+	v.defaulters[reflect.TypeOf(sample)] = fn
 }
 
-// StructFilteredCtx validates a structs exposed fields, that pass the FilterFunc check and automatically validates
-// nested structs, unless otherwise specified and also allows passing of contextual validation information via
-// context.Context
+// StructRules returns the validation rules of the struct s, or pointer to one, as data keyed by the namespace of
+// each field, as returned by FieldError.Namespace; eg. for generating client side validations from the same
+// source of truth. It reads the cached struct metadata without validating and returns nil when s isn't a struct.
 //
-// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
-// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
-func (v *Validate) StructFilteredCtx(ctx context.Context, s interface{}, fn FilterFunc) (err error) {
-	val := reflect.ValueOf(s)
-	top := val
+// Rules are listed in the order of the field's tag chain; dive, keys and endkeys are rules of their own with the
+// rules following them applying to the elements, or keys, as when validating. The fields of nested structs are
+// included using their namespace, with those of struct elements dived into using '[]' in place of an index or key
+// eg. 'User.Addresses[].Street'. Cross-field params, such as eqfield=Password, name the field relative to the
+// struct holding it. Fields without rules are omitted and recursive types are only expanded once per path.
+func (v *Validate) StructRules(s interface{}) map[string][]Rule {
 
-	if val.Kind() == reflect.Ptr && !val.IsNil() {
-		val = val.Elem()
-	}
+	typ := reflect.TypeOf(s)
 
-	if val.Kind() != reflect.Struct || val.Type() == timeType {
-		return &InvalidValidationError{Type: reflect.TypeOf(s)}
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
 	}
 
-	// good to validate
-	vd := v.pool.Get().(*validate)
-	vd.top = top
-	vd.isPartial = true
-	vd.ffn = fn
-	// vd.hasExcludes = false // only need to reset in StructPartial and StructExcept
+	if typ == nil || typ.Kind() != reflect.Struct || typ == timeType || v.isValueStruct(typ) {
+		return nil
+	}
 
-	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+	rules := make(map[string][]Rule)
+	ns := ""
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
+	if name := structName(typ); len(name) > 0 {
+		ns = name + v.nsSeparator
 	}
 
-	v.pool.Put(vd)
-
-	return
+	v.collectRules(typ, ns, make(map[reflect.Type]struct{}), rules)
+	return rules
 }
 
-// StructPartial validates the fields passed in only, ignoring all others.
+// RegisterOpaqueType marks the struct types of the samples as opaque, leaf, values which are never recursed into;
+// only the validation tags of the fields holding them are run against them, as for time.Time. Pointer samples
+// mark the type pointed to. Like time.Time they are validated using Var, Struct returning InvalidValidationError.
+//
+// Only time.Time is validated as a value by default; WithStdlibOpaqueTypes registers other common standard library
+// types such as url.URL and big.Int.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterOpaqueType(samples ...interface{}) {
+
+	if v.opaqueTypes == nil {
+		v.opaqueTypes = make(map[reflect.Type]struct{})
+	}
+
+	for _, sample := range samples {
+		typ := reflect.TypeOf(sample)
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		v.opaqueTypes[typ] = struct{}{}
+	}
+}
+
+// isValueStruct returns whether the struct type is validated as a value, with its validation tags run against
+// it, rather than being descended into.
+func (v *Validate) isValueStruct(typ reflect.Type) bool {
+	if _, ok := v.opaqueTypes[typ]; ok {
+		return true
+	}
+	if _, ok := v.iterators[typ]; ok {
+		return true
+	}
+	_, ok := v.zeroFuncs[typ]
+	return ok
+}
+
+// RegisterTranslation registers translations against the provided tag.
+func (v *Validate) RegisterTranslation(tag string, trans ut.Translator, registerFn RegisterTranslationsFunc, translationFn TranslationFunc) (err error) {
+
+	if v.transTagFunc == nil {
+		v.transTagFunc = make(map[ut.Translator]map[string]TranslationFunc)
+	}
+
+	if err = registerFn(trans); err != nil {
+		return
+	}
+
+	m, ok := v.transTagFunc[trans]
+	if !ok {
+		m = make(map[string]TranslationFunc)
+		v.transTagFunc[trans] = m
+	}
+
+	m[tag] = translationFn
+
+	return
+}
+
+// SetCacheSize bounds the number of struct types whose parsed metadata is cached, evicting the
+// least recently used once exceeded; a size <= 0, the default, leaves the cache unbounded.
+// Calling it resets any metadata cached so far.
+//
+// NOTE: this is intended for long running processes validating a large number of distinct, eg. anonymous,
+// struct types. A bounded cache requires a lock to track usage on every lookup, adding contention under
+// high concurrency, and evicted types have to be parsed again on their next validation.
+// This method is not thread-safe it is intended that it be called prior to any validation
+func (v *Validate) SetCacheSize(size int) {
+	v.structCache.setMaxSize(size)
+}
+
+// SetNamespaceSeparator sets the separator used to join struct and field names when building
+// the Namespace() and StructNamespace() of errors, the default being ".".
+//
+// eg. SetNamespaceSeparator("/") results in "User/Addresses[0]/Street"
+//
+// Field names passed to StructPartial and StructExcept continue to use the default syntax
+// eg. "Addresses[0].Street" and are translated to the configured one, whereas StructFiltered
+// receives namespaces using the configured separator.
+//
+// NOTE: namespaces are built by appending to reusable byte slices, so any separator is as cheap
+// as the default one, however StructPartial and StructExcept need to translate their field names.
+// This method is not thread-safe it is intended that these all be set prior to any validation
+func (v *Validate) SetNamespaceSeparator(sep string) {
+	if len(sep) == 0 {
+		panic("Namespace separator cannot be empty")
+	}
+	v.nsSeparator = sep
+	v.updateNamespaceReplacer()
+}
+
+// SetOmitTopNamespace omits the top level struct name from the namespaces of errors, as returned by Namespace
+// and StructNamespace and used by Error and Translate, so that they start at the first field eg. Email rather
+// than User.Email. Any index or key added by StructAll, StructMap and the like is kept eg. [0].Email.
+// The default, false, includes it.
+//
+// NOTE: this method is not thread-safe it is intended that these all be set prior to any validation
+func (v *Validate) SetOmitTopNamespace(omit bool) {
+	v.omitTopNs = omit
+}
+
+// SetEmptyStringAsNil treats a pointer to an empty string, eg. *string pointing at "", as though it were nil for
+// the purposes of required, omitempty and the other validations checking a field has a value; following the common
+// JSON API convention of sending "" to mean unset. Only the interpretation changes, the value isn't modified. The
+// default, false, treats any non-nil pointer as having a value.
+//
+// NOTE: this method is not thread-safe it is intended that these all be set prior to any validation
+func (v *Validate) SetEmptyStringAsNil(emptyAsNil bool) {
+	v.emptyStringAsNil = emptyAsNil
+}
+
+// SetNamespaceBrackets sets the strings surrounding slice and array indexes and map keys within
+// namespaces, the defaults being "[" and "]".
+//
+// eg. SetNamespaceSeparator("/") combined with SetNamespaceBrackets("/", "") results in the JSON Pointer
+// like "User/Addresses/0/Street"
+//
+// NOTE: this method is not thread-safe it is intended that these all be set prior to any validation
+func (v *Validate) SetNamespaceBrackets(left, right string) {
+	v.nsLeftBracket = left
+	v.nsRightBracket = right
+	v.updateNamespaceReplacer()
+}
+
+func (v *Validate) updateNamespaceReplacer() {
+	if v.nsSeparator == namespaceSeparator && v.nsLeftBracket == leftBracket && v.nsRightBracket == rightBracket {
+		v.nsReplacer = nil
+		return
+	}
+	v.nsReplacer = strings.NewReplacer(namespaceSeparator, v.nsSeparator, leftBracket, v.nsLeftBracket, rightBracket, v.nsRightBracket)
+}
+
+// appendNamespace appends the namespace, in the default syntax, to b translated
+// into the configured namespace separator and brackets.
+func (v *Validate) appendNamespace(b []byte, ns string) []byte {
+	if v.nsReplacer == nil {
+		return append(b, ns...)
+	}
+	return append(b, v.nsReplacer.Replace(ns)...)
+}
+
+// RegisterErrorCode registers a machine readable error code against the provided tag, which
+// is returned by FieldError.Code() for any error failing on that tag. Tags without a registered
+// code return the tag itself.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterErrorCode(tag, code string) {
+
+	if v.errorCodes == nil {
+		v.errorCodes = make(map[string]string)
+	}
+
+	v.errorCodes[tag] = code
+}
+
+// Struct validates a structs exposed fields, and automatically validates nested structs, unless otherwise specified.
+// Pointers to structs are dereferenced to any depth eg. **User, a nil pointer at any level is reported
+// as an InvalidValidationError.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) Struct(s interface{}) error {
+	return v.StructCtx(context.Background(), s)
+}
+
+// StructCtx validates a structs exposed fields, and automatically validates nested structs, unless otherwise specified
+// and also allows passing of context.Context for contextual validation information.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructCtx(ctx context.Context, s interface{}) (err error) {
+	return v.StructValueCtx(ctx, reflect.ValueOf(s))
+}
+
+// StructErrors validates a structs exposed fields, and automatically validates nested structs, as Struct
+// does returning the ValidationErrors directly, nil when valid, removing the need for a type assertion.
+//
+// It returns InvalidValidationError for bad values passed in, or MissingTagsError, as error.
+func (v *Validate) StructErrors(s interface{}) (ValidationErrors, error) {
+	return splitValidationErrors(v.StructCtx(context.Background(), s))
+}
+
+// StructErrorsCtx validates a structs exposed fields, as StructErrors does, and also allows passing of
+// contextual validation information via context.Context.
+//
+// It returns InvalidValidationError for bad values passed in, or MissingTagsError, as error.
+func (v *Validate) StructErrorsCtx(ctx context.Context, s interface{}) (ValidationErrors, error) {
+	return splitValidationErrors(v.StructCtx(ctx, s))
+}
+
+// StructValue validates a structs exposed fields from an already reflected value, and automatically
+// validates nested structs, unless otherwise specified. It avoids re-boxing a reflect.Value into
+// an interface{} for callers that already work with reflection.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructValue(val reflect.Value) error {
+	return v.StructValueCtx(context.Background(), val)
+}
+
+// StructValueCtx does the same as StructValue and also allows passing of context.Context for contextual
+// validation information.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructValueCtx(ctx context.Context, val reflect.Value) (err error) {
+	top := val
+
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type() == timeType || v.isValueStruct(val.Type()) {
+		return &InvalidValidationError{Type: typeOf(top), Kind: val.Kind(), valueStruct: val.Kind() == reflect.Struct}
+	}
+
+	if err = v.checkFieldTags(val.Type()); err != nil {
+		return
+	}
+
+	// good to validate
+	vd := v.pool.Get().(*validate)
+	vd.top = top
+	vd.isPartial = false
+	// vd.hasExcludes = false // only need to reset in StructPartial and StructExcept
+
+	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+
+	if len(vd.errs) > 0 {
+		err = vd.cappedErrs()
+		vd.errs = nil
+	}
+
+	vd.ctx = nil
+	v.pool.Put(vd)
+
+	return
+}
+
+// StructCoerce validates a structs exposed fields, as Struct does, after first parsing the string fields tagged
+// 'coerce=Target' into their numeric sibling field Target, eg. a numeric string received by an API.
+// s must be a pointer to the struct as it IS MODIFIED; targets are only set when their string parses, otherwise
+// they're left untouched for validation, such as numeric on the string field, to report.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructCoerce(s interface{}) error {
+	return v.StructCoerceCtx(context.Background(), s)
+}
+
+// StructCoerceCtx does the same as StructCoerce and also allows passing of context.Context for contextual
+// validation information.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructCoerceCtx(ctx context.Context, s interface{}) error {
+
+	val := reflect.ValueOf(s)
+
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type() == timeType || v.isValueStruct(val.Type()) {
+		return &InvalidValidationError{Type: reflect.TypeOf(s), Kind: val.Kind(), valueStruct: val.Kind() == reflect.Struct}
+	}
+
+	// the fields are set so the struct must have been reached through a pointer
+	if !val.CanAddr() {
+		return &InvalidValidationError{Type: reflect.TypeOf(s), Kind: val.Kind(),
+			reason: "StructCoerce requires a pointer to a struct but got " + val.Type().String()}
+	}
+
+	v.coerceStruct(val, make(map[uintptr]struct{}))
+
+	return v.StructCtx(ctx, s)
+}
+
+// Valid validates a structs exposed fields, as Struct does, returning only whether it's valid; stopping at the
+// first error and without allocating errors when valid, for hot paths which don't need the errors.
+//
+// It returns false for bad values passed in, and for structs lacking required tags, as Struct would return an error.
+func (v *Validate) Valid(s interface{}) bool {
+	return v.ValidCtx(context.Background(), s)
+}
+
+// ValidCtx does the same as Valid and also allows passing of context.Context for contextual validation information.
+func (v *Validate) ValidCtx(ctx context.Context, s interface{}) bool {
+	val := reflect.ValueOf(s)
+	top := val
+
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type() == timeType || v.isValueStruct(val.Type()) {
+		return false
+	}
+
+	if err := v.checkFieldTags(val.Type()); err != nil {
+		return false
+	}
+
+	vd := v.pool.Get().(*validate)
+	vd.top = top
+	vd.isPartial = false
+	vd.errLimit = 1
+
+	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+
+	valid := len(vd.errs) == 0
+
+	vd.errs = nil
+	vd.errLimit = 0
+
+	vd.ctx = nil
+	v.pool.Put(vd)
+
+	return valid
+}
+
+// StructN validates a structs exposed fields, as Struct does, stopping once n errors have been collected and
+// returning at most the first n; eg. to display only the first few errors, without the cost of validating the
+// remaining fields of bad input. Errors are collected in a stable order, fields in their declaration order and map
+// elements by key, so the same errors are returned for the same input. n <= 0 collects all errors as Struct does.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructN(s interface{}, n int) error {
+	return v.StructNCtx(context.Background(), s, n)
+}
+
+// StructNCtx does the same as StructN and also allows passing of context.Context for contextual validation
+// information.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructNCtx(ctx context.Context, s interface{}, n int) (err error) {
+	val := reflect.ValueOf(s)
+	top := val
+
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type() == timeType || v.isValueStruct(val.Type()) {
+		return &InvalidValidationError{Type: reflect.TypeOf(s), Kind: val.Kind(), valueStruct: val.Kind() == reflect.Struct}
+	}
+
+	if err = v.checkFieldTags(val.Type()); err != nil {
+		return
+	}
+
+	// good to validate
+	vd := v.pool.Get().(*validate)
+	vd.top = top
+	vd.isPartial = false
+	vd.errLimit = n
+
+	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+
+	if len(vd.errs) > 0 {
+		// a struct level validation may report several errors at once
+		if n > 0 && len(vd.errs) > n {
+			vd.errs = vd.errs[:n]
+		}
+		err = vd.cappedErrs()
+		vd.errs = nil
+	}
+
+	vd.errLimit = 0
+
+	vd.ctx = nil
+	v.pool.Put(vd)
+
+	return
+}
+
+// StructFiltered validates a structs exposed fields, that pass the FilterFunc check and automatically validates
+// nested structs, unless otherwise specified.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructFiltered(s interface{}, fn FilterFunc) error {
+	return v.StructFilteredCtx(context.Background(), s, fn)
+}
+
+// StructFilteredCtx validates a structs exposed fields, that pass the FilterFunc check and automatically validates
+// nested structs, unless otherwise specified and also allows passing of contextual validation information via
+// context.Context
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructFilteredCtx(ctx context.Context, s interface{}, fn FilterFunc) (err error) {
+	val := reflect.ValueOf(s)
+	top := val
+
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type() == timeType || v.isValueStruct(val.Type()) {
+		return &InvalidValidationError{Type: reflect.TypeOf(s), Kind: val.Kind(), valueStruct: val.Kind() == reflect.Struct}
+	}
+
+	if err = v.checkFieldTags(val.Type()); err != nil {
+		return
+	}
+
+	// good to validate
+	vd := v.pool.Get().(*validate)
+	vd.top = top
+	vd.isPartial = true
+	vd.ffn = fn
+	// vd.hasExcludes = false // only need to reset in StructPartial and StructExcept
+
+	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+
+	if len(vd.errs) > 0 {
+		err = vd.cappedErrs()
+		vd.errs = nil
+	}
+
+	vd.ctx = nil
+	v.pool.Put(vd)
+
+	return
+}
+
+// StructChanged validates, as Struct does, only the fields of updated whose value differs from that of the same
+// field in original, a struct of the same type, as compared by reflect.DeepEqual; eg. to validate a partial update
+// against the stored record without reporting errors of fields the update didn't touch.
+//
+// Nested structs, and pointers to them when set in both, are compared field by field, so only their changed fields
+// are validated, along with the tags of the struct field itself. Any other changed field, such as a slice, map or
+// a struct pointer set in only one of them, is validated in full including any elements dived into; unchanged ones
+// aren't validated at all. Struct level validations still run for the top level struct and any nested structs
+// traversed.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructChanged(updated, original interface{}) error {
+	return v.StructChangedCtx(context.Background(), updated, original)
+}
+
+// StructChangedCtx validates only the fields of updated differing from original, as StructChanged does, and also
+// allows passing of contextual validation information via context.Context.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructChangedCtx(ctx context.Context, updated, original interface{}) error {
+	val := reflect.ValueOf(updated)
+	orig := reflect.ValueOf(original)
+
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	for orig.Kind() == reflect.Ptr && !orig.IsNil() {
+		orig = orig.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type() == timeType || v.isValueStruct(val.Type()) {
+		return &InvalidValidationError{Type: reflect.TypeOf(updated), Kind: val.Kind(), valueStruct: val.Kind() == reflect.Struct}
+	}
+
+	if orig.Kind() != reflect.Struct {
+		return &InvalidValidationError{Type: reflect.TypeOf(original), Kind: orig.Kind()}
+	}
+
+	if orig.Type() != val.Type() {
+		return &InvalidValidationError{Type: reflect.TypeOf(original), Kind: orig.Kind(),
+			reason: "original " + orig.Type().String() + " doesn't match updated " + val.Type().String()}
+	}
+
+	prefix := structName(val.Type())
+	if len(prefix) > 0 {
+		prefix += v.nsSeparator
+	}
+
+	changed := make(map[string]bool)
+	v.diffStruct(val, orig, prefix, changed)
+
+	return v.StructFilteredCtx(ctx, updated, func(ns []byte) bool {
+		return !v.isChanged(changed, ns)
+	})
+}
+
+// StructPartial validates the fields passed in only, ignoring all others.
 // Fields may be provided in a namespaced fashion relative to the  struct provided
 // eg. NestedStruct.Field or NestedArrayField[0].Struct.Name
 //
@@ -416,12 +1384,16 @@ func (v *Validate) StructPartialCtx(ctx context.Context, s interface{}, fields .
 	val := reflect.ValueOf(s)
 	top := val
 
-	if val.Kind() == reflect.Ptr && !val.IsNil() {
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
 		val = val.Elem()
 	}
 
-	if val.Kind() != reflect.Struct || val.Type() == timeType {
-		return &InvalidValidationError{Type: reflect.TypeOf(s)}
+	if val.Kind() != reflect.Struct || val.Type() == timeType || v.isValueStruct(val.Type()) {
+		return &InvalidValidationError{Type: reflect.TypeOf(s), Kind: val.Kind(), valueStruct: val.Kind() == reflect.Struct}
+	}
+
+	if err = v.checkFieldTags(val.Type()); err != nil {
+		return
 	}
 
 	// good to validate
@@ -433,7 +1405,7 @@ func (v *Validate) StructPartialCtx(ctx context.Context, s interface{}, fields .
 	vd.includeExclude = make(map[string]struct{})
 
 	typ := val.Type()
-	name := typ.Name()
+	name := structName(typ)
 
 	for _, k := range fields {
 
@@ -443,7 +1415,7 @@ func (v *Validate) StructPartialCtx(ctx context.Context, s interface{}, fields .
 			vd.misc = append(vd.misc[0:0], name...)
 			// Don't append empty name for unnamed structs
 			if len(vd.misc) != 0 {
-				vd.misc = append(vd.misc, '.')
+				vd.misc = append(vd.misc, v.nsSeparator...)
 			}
 
 			for _, s := range flds {
@@ -457,7 +1429,7 @@ func (v *Validate) StructPartialCtx(ctx context.Context, s interface{}, fields .
 
 						idx2 := strings.Index(s, rightBracket)
 						idx2++
-						vd.misc = append(vd.misc, s[idx:idx2]...)
+						vd.misc = v.appendNamespace(vd.misc, s[idx:idx2])
 						vd.includeExclude[string(vd.misc)] = struct{}{}
 						s = s[idx2:]
 						idx = strings.Index(s, leftBracket)
@@ -468,7 +1440,7 @@ func (v *Validate) StructPartialCtx(ctx context.Context, s interface{}, fields .
 					vd.includeExclude[string(vd.misc)] = struct{}{}
 				}
 
-				vd.misc = append(vd.misc, '.')
+				vd.misc = append(vd.misc, v.nsSeparator...)
 			}
 		}
 	}
@@ -476,10 +1448,11 @@ func (v *Validate) StructPartialCtx(ctx context.Context, s interface{}, fields .
 	vd.validateStruct(ctx, top, val, typ, vd.ns[0:0], vd.actualNs[0:0], nil)
 
 	if len(vd.errs) > 0 {
-		err = vd.errs
+		err = vd.cappedErrs()
 		vd.errs = nil
 	}
 
+	vd.ctx = nil
 	v.pool.Put(vd)
 
 	return
@@ -506,12 +1479,16 @@ func (v *Validate) StructExceptCtx(ctx context.Context, s interface{}, fields ..
 	val := reflect.ValueOf(s)
 	top := val
 
-	if val.Kind() == reflect.Ptr && !val.IsNil() {
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
 		val = val.Elem()
 	}
 
-	if val.Kind() != reflect.Struct || val.Type() == timeType {
-		return &InvalidValidationError{Type: reflect.TypeOf(s)}
+	if val.Kind() != reflect.Struct || val.Type() == timeType || v.isValueStruct(val.Type()) {
+		return &InvalidValidationError{Type: reflect.TypeOf(s), Kind: val.Kind(), valueStruct: val.Kind() == reflect.Struct}
+	}
+
+	if err = v.checkFieldTags(val.Type()); err != nil {
+		return
 	}
 
 	// good to validate
@@ -523,7 +1500,7 @@ func (v *Validate) StructExceptCtx(ctx context.Context, s interface{}, fields ..
 	vd.includeExclude = make(map[string]struct{})
 
 	typ := val.Type()
-	name := typ.Name()
+	name := structName(typ)
 
 	for _, key := range fields {
 
@@ -531,25 +1508,384 @@ func (v *Validate) StructExceptCtx(ctx context.Context, s interface{}, fields ..
 
 		if len(name) > 0 {
 			vd.misc = append(vd.misc, name...)
-			vd.misc = append(vd.misc, '.')
+			vd.misc = append(vd.misc, v.nsSeparator...)
 		}
 
-		vd.misc = append(vd.misc, key...)
+		vd.misc = v.appendNamespace(vd.misc, key)
 		vd.includeExclude[string(vd.misc)] = struct{}{}
 	}
 
 	vd.validateStruct(ctx, top, val, typ, vd.ns[0:0], vd.actualNs[0:0], nil)
 
 	if len(vd.errs) > 0 {
-		err = vd.errs
+		err = vd.cappedErrs()
+		vd.errs = nil
+	}
+
+	vd.ctx = nil
+	v.pool.Put(vd)
+
+	return
+}
+
+// StructSkippingTag validates a structs exposed fields, and automatically validates nested structs,
+// skipping any field, at any depth, carrying a non-empty structTag eg. StructSkippingTag(s, "sensitive")
+// skips fields tagged `sensitive:"true"`.
+//
+// Skipping is decided per struct field, so a slice, array or map field carrying the tag is skipped
+// along with everything validated by dive, while the fields of structs reached through dive are
+// checked for the tag like any other struct.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructSkippingTag(s interface{}, structTag string) error {
+	return v.StructSkippingTagCtx(context.Background(), s, structTag)
+}
+
+// StructSkippingTagCtx does the same as StructSkippingTag and also allows passing of context.Context
+// for contextual validation information.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructSkippingTagCtx(ctx context.Context, s interface{}, structTag string) (err error) {
+	val := reflect.ValueOf(s)
+	top := val
+
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type() == timeType || v.isValueStruct(val.Type()) {
+		return &InvalidValidationError{Type: reflect.TypeOf(s), Kind: val.Kind(), valueStruct: val.Kind() == reflect.Struct}
+	}
+
+	if err = v.checkFieldTags(val.Type()); err != nil {
+		return
+	}
+
+	// good to validate
+	vd := v.pool.Get().(*validate)
+	vd.top = top
+	vd.isPartial = false
+	vd.skipTag = structTag
+
+	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+
+	if len(vd.errs) > 0 {
+		err = vd.cappedErrs()
+		vd.errs = nil
+	}
+
+	vd.skipTag = ""
+	vd.ctx = nil
+	v.pool.Put(vd)
+
+	return
+}
+
+// StructWithRules validates a structs exposed fields, and automatically validates nested structs, applying
+// the rules, a map of field path to tag, in place of the fields' struct tags; enabling validation rules to
+// be configured externally, eg. stored as data, without recompiling.
+//
+// A field's path is the chain of its and its parent structs' actual Go field names, relative to the struct
+// being validated and joined by the namespace separator, eg. Address.City; slice, array and map elements
+// are passed through without an index or key so Items.Name addresses the Name field of every element of
+// Items, while Items itself may be given dive rules. A rule takes precedence over, replacing, the field's
+// struct tag and applies to fields lacking one; '-' skips the field. Fields skipped by their struct tag,
+// or unexported, can't be given rules.
+//
+// It panics when a rule's path doesn't lead to a field.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructWithRules(s interface{}, rules map[string]string) error {
+	return v.StructWithRulesCtx(context.Background(), s, rules)
+}
+
+// StructWithRulesCtx validates a structs exposed fields applying the rules, as StructWithRules does, and also
+// allows passing of contextual validation information via context.Context.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructWithRulesCtx(ctx context.Context, s interface{}, rules map[string]string) (err error) {
+	val := reflect.ValueOf(s)
+	top := val
+
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type() == timeType || v.isValueStruct(val.Type()) {
+		return &InvalidValidationError{Type: reflect.TypeOf(s), Kind: val.Kind(), valueStruct: val.Kind() == reflect.Struct}
+	}
+
+	if err = v.checkFieldTags(val.Type()); err != nil {
+		return
+	}
+
+	fieldRules := make(map[string]*cTag, len(rules))
+
+	for path, tag := range rules {
+		if !v.hasFieldPath(val.Type(), path) {
+			panic(fmt.Sprintf("Field path '%s' not found on struct %s", path, val.Type()))
+		}
+
+		switch tag {
+		case skipValidationTag:
+			fieldRules[path] = nil
+		case "":
+			fieldRules[path] = new(cTag)
+		default:
+			fieldRules[path] = v.fetchCacheTag(tag)
+		}
+	}
+
+	// good to validate
+	vd := v.pool.Get().(*validate)
+	vd.top = top
+	vd.isPartial = false
+	vd.rules = fieldRules
+
+	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+
+	if len(vd.errs) > 0 {
+		err = vd.cappedErrs()
 		vd.errs = nil
 	}
 
+	vd.rules = nil
+	vd.ctx = nil
 	v.pool.Put(vd)
 
 	return
 }
 
+// hasFieldPath returns whether the path of Go field names, passing through pointers, slices, arrays and maps,
+// leads to an exported field of the struct type.
+func (v *Validate) hasFieldPath(typ reflect.Type, path string) bool {
+	for _, name := range strings.Split(path, v.nsSeparator) {
+
+		for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+			typ = typ.Elem()
+		}
+
+		if typ.Kind() != reflect.Struct {
+			return false
+		}
+
+		fld, ok := typ.FieldByName(name)
+		if !ok || len(fld.Index) != 1 || (!fld.Anonymous && len(fld.PkgPath) > 0) {
+			return false
+		}
+
+		typ = fld.Type
+	}
+
+	return true
+}
+
+// StructBatch validates each of the items concurrently, using up to concurrency goroutines, returning their
+// errors in the same order as items; an item's error is nil when valid, an InvalidValidationError for a bad
+// value or ValidationErrors otherwise. A concurrency less than 1 uses runtime.GOMAXPROCS.
+//
+// Each goroutine validates using its own pooled state, as concurrent calls to Struct do. A panic validating an
+// item, eg. due to an undefined tag, stops the batch and is raised again on the calling goroutine.
+func (v *Validate) StructBatch(items []interface{}, concurrency int) []error {
+	return v.StructBatchCtx(context.Background(), items, concurrency)
+}
+
+// StructBatchCtx validates each of the items concurrently, as StructBatch does, and also allows passing of
+// contextual validation information via context.Context. Once ctx is done the items not yet validated aren't,
+// their error being ctx.Err().
+func (v *Validate) StructBatchCtx(ctx context.Context, items []interface{}, concurrency int) []error {
+	errs := make([]error, len(items))
+
+	if concurrency < 1 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	if concurrency > len(items) {
+		concurrency = len(items)
+	}
+
+	var next int64 = -1
+	var wg sync.WaitGroup
+
+	// the first panic of a worker, eg. an undefined tag, is re-raised on the calling goroutine as Struct would
+	var panicOnce sync.Once
+	var panicked interface{}
+	var stop int32
+
+	wg.Add(concurrency)
+
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() { panicked = r })
+					atomic.StoreInt32(&stop, 1)
+				}
+			}()
+
+			for atomic.LoadInt32(&stop) == 0 {
+				idx := int(atomic.AddInt64(&next, 1))
+				if idx >= len(items) {
+					return
+				}
+
+				// items not yet taken once ctx is done aren't validated
+				if err := ctx.Err(); err != nil {
+					errs[idx] = err
+					continue
+				}
+
+				errs[idx] = v.StructCtx(ctx, items[idx])
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	return errs
+}
+
+// StructAll validates each of the structs, combining their errors into a single ValidationErrors
+// so a batch may be validated at once.
+//
+// The top level struct name of each error's namespace has the struct's index within ss appended,
+// eg. the errors of the second User are namespaced 'User[1].Email' rather than 'User.Email', or
+// '[1].Email' for an anonymous struct, so they reference which item failed.
+//
+// It returns InvalidValidationError for the first bad value passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructAll(ss ...interface{}) error {
+	return v.StructAllCtx(context.Background(), ss...)
+}
+
+// StructAllCtx validates each of the structs, combining their errors, as StructAll does and also allows
+// passing of contextual validation information via context.Context.
+//
+// It returns InvalidValidationError for the first bad value passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructAllCtx(ctx context.Context, ss ...interface{}) error {
+
+	var all ValidationErrors
+
+	for i, s := range ss {
+
+		err := v.StructCtx(ctx, s)
+		if err == nil {
+			continue
+		}
+
+		errs, ok := err.(ValidationErrors)
+		if !ok {
+			return err
+		}
+
+		all = v.appendIndexed(all, errs, strconv.Itoa(i))
+	}
+
+	if len(all) > 0 {
+		return all
+	}
+
+	return nil
+}
+
+// StructMap validates each of the struct values of the map m, eg. a map[string]ServerConfig, combining
+// their errors into a single ValidationErrors. Values are validated in sorted key order and may also be
+// pointers to structs.
+//
+// The top level struct name of each error's namespace has the value's key appended, eg. the errors of
+// the "primary" ServerConfig are namespaced 'ServerConfig[primary].Port' rather than 'ServerConfig.Port'.
+//
+// It returns InvalidValidationError if m isn't a map or for the first value that isn't a struct, and nil
+// or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructMap(m interface{}) error {
+	return v.StructMapCtx(context.Background(), m)
+}
+
+// StructMapCtx validates each of the struct values of the map m, combining their errors, as StructMap does
+// and also allows passing of contextual validation information via context.Context.
+//
+// It returns InvalidValidationError if m isn't a map or for the first value that isn't a struct, and nil
+// or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructMapCtx(ctx context.Context, m interface{}) error {
+
+	val := reflect.ValueOf(m)
+
+	if val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Map {
+		return &InvalidValidationError{Type: reflect.TypeOf(m), Kind: val.Kind()}
+	}
+
+	var all ValidationErrors
+
+	keys := val.MapKeys()
+	sortMapKeys(keys)
+
+	for _, key := range keys {
+
+		err := v.StructCtx(ctx, val.MapIndex(key).Interface())
+		if err == nil {
+			continue
+		}
+
+		errs, ok := err.(ValidationErrors)
+		if !ok {
+			return err
+		}
+
+		all = v.appendIndexed(all, errs, fmt.Sprintf("%v", key.Interface()))
+	}
+
+	if len(all) > 0 {
+		return all
+	}
+
+	return nil
+}
+
+// appendIndexed appends errs to all, indexing their namespaces by idx as indexNamespace does.
+func (v *Validate) appendIndexed(all ValidationErrors, errs ValidationErrors, idx string) ValidationErrors {
+	for _, e := range errs {
+		fe := e.(*fieldError)
+		l := len(fe.ns)
+		fe.ns = v.indexNamespace(fe.ns, fe.rootLen, idx)
+		fe.structNs = v.indexNamespace(fe.structNs, fe.rootLen, idx)
+		fe.rootLen += len(fe.ns) - l
+		all = append(all, fe)
+	}
+	return all
+}
+
+// indexNamespace appends the index, or map key, to the top level struct name, of length rootLen
+// including its separator, prefixing the namespace.
+func (v *Validate) indexNamespace(ns string, rootLen int, idx string) string {
+	b := make([]byte, 0, len(ns)+len(idx)+8)
+
+	if rootLen > 0 {
+		b = append(b, ns[:rootLen-len(v.nsSeparator)]...)
+	}
+
+	b = append(b, v.nsLeftBracket...)
+	b = append(b, idx...)
+	b = append(b, v.nsRightBracket...)
+	b = append(b, v.nsSeparator...)
+
+	return string(append(b, ns[rootLen:]...))
+}
+
 // Var validates a single variable using tag style validation.
 // eg.
 // var i int
@@ -582,21 +1918,67 @@ func (v *Validate) Var(field interface{}, tag string) error {
 // You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
 // validate Array, Slice and maps fields which may contain more than one error
 func (v *Validate) VarCtx(ctx context.Context, field interface{}, tag string) (err error) {
+	return v.VarValueCtx(ctx, reflect.ValueOf(field), tag)
+}
+
+// VarErrors validates a single variable using tag style validation, as Var does returning the
+// ValidationErrors directly, nil when valid, removing the need for a type assertion.
+//
+// It returns InvalidValidationError for bad values passed in as error.
+func (v *Validate) VarErrors(field interface{}, tag string) (ValidationErrors, error) {
+	return splitValidationErrors(v.VarCtx(context.Background(), field, tag))
+}
+
+// VarErrorsCtx validates a single variable using tag style validation, as VarErrors does, and also
+// allows passing of contextual validation information via context.Context.
+//
+// It returns InvalidValidationError for bad values passed in as error.
+func (v *Validate) VarErrorsCtx(ctx context.Context, field interface{}, tag string) (ValidationErrors, error) {
+	return splitValidationErrors(v.VarCtx(ctx, field, tag))
+}
+
+// splitValidationErrors separates the ValidationErrors from any other error returned by a validation.
+func splitValidationErrors(err error) (ValidationErrors, error) {
+	if err == nil {
+		return nil, nil
+	}
+	if errs, ok := err.(ValidationErrors); ok {
+		return errs, nil
+	}
+	return nil, err
+}
+
+// VarValue validates a single already reflected variable using tag style validation.
+// It avoids re-boxing a reflect.Value into an interface{} for callers that already work with reflection.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) VarValue(val reflect.Value, tag string) error {
+	return v.VarValueCtx(context.Background(), val, tag)
+}
+
+// VarValueCtx does the same as VarValue and also allows passing of contextual validation information
+// via context.Context.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) VarValueCtx(ctx context.Context, val reflect.Value, tag string) (err error) {
 	if len(tag) == 0 || tag == skipValidationTag {
 		return nil
 	}
 
 	ctag := v.fetchCacheTag(tag)
-	val := reflect.ValueOf(field)
 	vd := v.pool.Get().(*validate)
 	vd.top = val
 	vd.isPartial = false
+	vd.rootLen = 0
 	vd.traverseField(ctx, val, val, vd.ns[0:0], vd.actualNs[0:0], defaultCField, ctag)
 
 	if len(vd.errs) > 0 {
-		err = vd.errs
+		err = vd.cappedErrs()
 		vd.errs = nil
 	}
+	vd.ctx = nil
 	v.pool.Put(vd)
 	return
 }
@@ -643,12 +2025,14 @@ func (v *Validate) VarWithValueCtx(ctx context.Context, field interface{}, other
 	vd := v.pool.Get().(*validate)
 	vd.top = otherVal
 	vd.isPartial = false
+	vd.rootLen = 0
 	vd.traverseField(ctx, otherVal, reflect.ValueOf(field), vd.ns[0:0], vd.actualNs[0:0], defaultCField, ctag)
 
 	if len(vd.errs) > 0 {
-		err = vd.errs
+		err = vd.cappedErrs()
 		vd.errs = nil
 	}
+	vd.ctx = nil
 	v.pool.Put(vd)
 	return
 }
@@ -8,9 +8,15 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/url"
 	"path/filepath"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -3388,7 +3394,7 @@ func TestArrayDiveValidation(t *testing.T) {
 		Name: "TEST",
 	}
 
-	PanicMatches(t, func() { _ = validate.Struct(bd) }, "dive error! can't dive on a non slice or map")
+	PanicMatches(t, func() { _ = validate.Struct(bd) }, "validator: dive used on non-collection type string of kind string on field 'Name'")
 
 	type Test struct {
 		Errs []string `validate:"gt=0,dive,required"`
@@ -3796,6 +3802,52 @@ func TestLatitudeValidation(t *testing.T) {
 	PanicMatches(t, func() { _ = validate.Var(true, "latitude") }, "Bad field type bool")
 }
 
+func TestImageDataURIValidation(t *testing.T) {
+	tests := []struct {
+		param    string
+		expected bool
+	}{
+		{"data:image/png;base64,TG9yZW0gaXBzdW0gZG9sb3Igc2l0IGFtZXQsIGNvbnNlY3RldHVyIGFkaXBpc2NpbmcgZWxpdC4=", true},
+		{"data:image/svg+xml;charset=utf-8,%3Csvg%3E%3C%2Fsvg%3E", true},
+		{"data:IMAGE/GIF;base64,R0lGODlhAQABAAAAACw=", true},
+		{"data:image/jpeg;name=avatar.jpg;base64,UEsDBBQAAAAI", true},
+		{"data:image/png;base64,", true},
+		{"data:text/plain;base64,Vml2YW11cyBmZXJtZW50dW0gc2VtcGVyIHBvcnRhLg==", false},
+		{"data:;base64,UEsDBBQAAAAI", false},
+		{"data:,UEsDBBQAAAAI", false},
+		{"data:image/png;base64,12345", false},
+		{"data:image/png;base64,U3Vz*GVuZGlzc2U=", false},
+		{"data:image/svg+xml,%E0%A4%A", false},
+		{"data:image/png;key,UEsDBBQAAAAI", false},
+		{"data:image/png;base64;key=value,UEsDBBQAAAAI", false},
+		{"data:image;base64,UEsDBBQAAAAI", false},
+		{"data:image/png;base64UEsDBBQAAAAI", false},
+		{"image/png;base64,UEsDBBQAAAAI", false},
+		{"", false},
+	}
+
+	validate := New()
+
+	for i, test := range tests {
+		errs := validate.Var(test.param, "datauri_image")
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d datauri_image failed Error: %s", i, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d datauri_image failed Error: %s", i, errs)
+			} else {
+				val := getError(errs, "", "")
+				if val.Tag() != "datauri_image" {
+					t.Fatalf("Index: %d datauri_image failed Error: %s", i, errs)
+				}
+			}
+		}
+	}
+}
+
 func TestDataURIValidation(t *testing.T) {
 	tests := []struct {
 		param    string
@@ -4308,6 +4360,11 @@ func TestISBN13Validation(t *testing.T) {
 		{"978-4-87311-368-5", true},
 		{"978 3401013190", true},
 		{"978-3-8362-2119-1", true},
+		{"978-0-306-40615-7", true},
+		{"978-0-306-40615-6", false},
+		{"979-10-90636-07-1", true},
+		{"977-0-306-40615-7", false},
+		{"978 0 306 40615 7", true},
 	}
 
 	validate := New()
@@ -4348,6 +4405,12 @@ func TestISBN10Validation(t *testing.T) {
 		{"1-61729-085-8", true},
 		{"3 423 21412 0", true},
 		{"3 401 01319 X", true},
+		{"0-306-40615-2", true},
+		{"0-306-40615-3", false},
+		{"0-8044-2957-X", true},
+		{"0-8044-2957-x", true},
+		{"0-8044-2957-Y", false},
+		{"080442957X0", false},
 	}
 
 	validate := New()
@@ -4713,6 +4776,48 @@ func TestIsNeFieldValidation(t *testing.T) {
 	Equal(t, errs, nil)
 }
 
+func TestEqNeLiteralAcrossKinds(t *testing.T) {
+	type Account struct {
+		Version int     `validate:"eq=5"`
+		Limit   uint8   `validate:"ne=0"`
+		Rate    float64 `validate:"eq=1.5"`
+		Status  string  `validate:"eq=active"`
+		Role    string  `validate:"ne=admin"`
+		Active  bool    `validate:"eq=true"`
+		Locked  *bool   `validate:"omitempty,ne=true"`
+	}
+
+	validate := New()
+	locked := false
+
+	errs := validate.Struct(Account{Version: 5, Limit: 1, Rate: 1.5, Status: "active", Role: "user", Active: true, Locked: &locked})
+	Equal(t, errs, nil)
+
+	locked = true
+
+	errs = validate.Struct(Account{Version: 4, Rate: 2, Status: "Active", Role: "admin", Locked: &locked})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 7)
+	AssertError(t, errs, "Account.Version", "Account.Version", "Version", "Version", "eq")
+	AssertError(t, errs, "Account.Limit", "Account.Limit", "Limit", "Limit", "ne")
+	AssertError(t, errs, "Account.Rate", "Account.Rate", "Rate", "Rate", "eq")
+	AssertError(t, errs, "Account.Status", "Account.Status", "Status", "Status", "eq")
+	AssertError(t, errs, "Account.Role", "Account.Role", "Role", "Role", "ne")
+	AssertError(t, errs, "Account.Active", "Account.Active", "Active", "Active", "eq")
+	AssertError(t, errs, "Account.Locked", "Account.Locked", "Locked", "Locked", "ne")
+
+	// the same literal compares by the field's kind
+	Equal(t, validate.Var(5, "eq=5"), nil)
+	Equal(t, validate.Var("5", "eq=5"), nil)
+	Equal(t, validate.Var(int64(-5), "eq=-5"), nil)
+	NotEqual(t, validate.Var("05", "eq=5"), nil)
+	Equal(t, validate.Var(false, "ne=true"), nil)
+	Equal(t, validate.Var(true, "eq=1"), nil)
+
+	PanicMatches(t, func() { _ = validate.Var(5, "eq=five") }, "strconv.ParseInt: parsing \"five\": invalid syntax")
+	PanicMatches(t, func() { _ = validate.Var(true, "eq=yes") }, "strconv.ParseBool: parsing \"yes\": invalid syntax")
+}
+
 func TestIsNeValidation(t *testing.T) {
 	var errs error
 	validate := New()
@@ -5111,6 +5216,199 @@ func TestOneOfValidation(t *testing.T) {
 	}, "Bad field type float64")
 }
 
+func TestRegisterDefaulter(t *testing.T) {
+	type Plan string
+	type Retries int
+
+	type Account struct {
+		Name    string
+		Plan    Plan     `validate:"oneof=free pro"`
+		Backup  *Plan    `validate:"omitempty,oneof=free pro"`
+		Retries *Retries `validate:"required,min=1"`
+		Plans   []Plan   `validate:"dive,oneof=free pro"`
+	}
+
+	validate := New()
+	validate.RegisterDefaulter(Plan(""), func(field reflect.Value) {
+		field.SetString("free")
+	})
+	validate.RegisterDefaulter(Retries(0), func(field reflect.Value) {
+		field.SetInt(3)
+	})
+
+	retries := Retries(0)
+	backup := Plan("")
+	a := Account{Retries: &retries, Backup: &backup, Plans: []Plan{"pro", ""}}
+
+	errs := validate.Struct(&a)
+	Equal(t, errs, nil)
+	Equal(t, a.Plan, Plan("free"))
+	Equal(t, backup, Plan("free"))
+	Equal(t, retries, Retries(3))
+	Equal(t, a.Plans, []Plan{"pro", "free"})
+	Equal(t, a.Name, "")
+
+	// non-zero values are left as is
+	a.Plan = "enterprise"
+	errs = validate.Struct(&a)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Account.Plan", "Account.Plan", "Plan", "Plan", "oneof")
+
+	// values which can't be set are validated as is
+	errs = validate.Struct(Account{Retries: &retries})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Account.Plan", "Account.Plan", "Plan", "Plan", "oneof")
+
+	errs = validate.Var(Plan(""), "oneof=free pro")
+	NotEqual(t, errs, nil)
+}
+
+func TestInSetValidation(t *testing.T) {
+	var plans atomic.Value
+	plans.Store(map[string]struct{}{"free": {}, "pro": {}})
+
+	validate := New()
+	validate.RegisterDynamicSet("plans", func() map[string]struct{} {
+		return plans.Load().(map[string]struct{})
+	})
+	validate.RegisterDynamicSet("codes", func() map[string]struct{} {
+		return map[string]struct{}{"7": {}, "42": {}}
+	})
+
+	type Account struct {
+		Plan string `validate:"inset=plans"`
+		Code uint8  `validate:"omitempty,inset=codes"`
+	}
+
+	errs := validate.Struct(Account{Plan: "pro", Code: 42})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Account{Plan: "enterprise", Code: 41})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "Account.Plan", "Account.Plan", "Plan", "Plan", "inset")
+	AssertError(t, errs, "Account.Code", "Account.Code", "Code", "Code", "inset")
+
+	// the set changes between calls without re-registering
+	plans.Store(map[string]struct{}{"free": {}, "enterprise": {}})
+
+	errs = validate.Struct(Account{Plan: "enterprise"})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Account{Plan: "pro"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Account.Plan", "Account.Plan", "Plan", "Plan", "inset")
+
+	errs = validate.Var(-7, "inset=codes")
+	NotEqual(t, errs, nil)
+
+	errs = validate.Var(7, "inset=codes")
+	Equal(t, errs, nil)
+
+	PanicMatches(t, func() { _ = validate.Var("pro", "inset=unknown") }, "Undefined dynamic set 'unknown' on field ''")
+	PanicMatches(t, func() { _ = validate.Var(1.5, "inset=codes") }, "Bad field type float64")
+	PanicMatches(t, func() { validate.RegisterDynamicSet("", nil) }, "RegisterDynamicSet: name cannot be empty")
+	PanicMatches(t, func() { validate.RegisterDynamicSet("nil", nil) }, "RegisterDynamicSet: provider cannot be nil")
+}
+
+func TestDigitsValidation(t *testing.T) {
+	validate := New()
+
+	tests := []struct {
+		value    interface{}
+		tag      string
+		expected bool
+	}{
+		{123456789, "digits=9", true},
+		{-123456789, "digits=9", true},
+		{12345678, "digits=9", false},
+		{int8(-128), "digits=3", true},
+		{uint64(1234567890), "digits=10", true},
+		{0, "digits=1", true},
+		{"123456789", "digits=9", true},
+		{"000000001", "digits=9", true},
+		{"-000000001", "digits=9", true},
+		{"+12", "digits=2", true},
+		{"12345678", "digits=9", false},
+		{"12345678a", "digits=9", false},
+		{"1234.5678", "digits=8", false},
+		{"", "digits=0", false},
+		{"-", "digits=0", false},
+		{123, "digits_between=3 5", true},
+		{12345, "digits_between=3 5", true},
+		{-99999, "digits_between=3 5", true},
+		{12, "digits_between=3 5", false},
+		{123456, "digits_between=3 5", false},
+		{"007", "digits_between=3 5", true},
+		{"07", "digits_between=3 5", false},
+		{"0x1F", "digits_between=3 5", false},
+	}
+
+	for i, test := range tests {
+		errs := validate.Var(test.value, test.tag)
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d %s failed Error: %s", i, test.tag, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d %s failed Error: %s", i, test.tag, errs)
+			}
+			tag := strings.SplitN(test.tag, "=", 2)[0]
+			AssertError(t, errs, "", "", "", "", tag)
+		}
+	}
+
+	PanicMatches(t, func() { _ = validate.Var(1.5, "digits=1") }, "Bad field type float64")
+	PanicMatches(t, func() { _ = validate.Var(1, "digits_between=1") }, "Bad param number for digits_between ")
+}
+
+func TestOneOfCIValidation(t *testing.T) {
+	validate := New()
+
+	passSpecs := []struct {
+		f interface{}
+		t string
+	}{
+		{f: "red", t: "oneofci=red green"},
+		{f: "RED", t: "oneofci=red green"},
+		{f: "Green", t: "oneofci=red green"},
+		{f: "gReEn", t: "oneofci=RED GREEN"},
+		{f: "dark red", t: "oneofci='Dark Red' blue"},
+		{f: "BLUE", t: "oneofci='Dark Red' blue"},
+	}
+
+	for _, spec := range passSpecs {
+		t.Logf("%#v", spec)
+		errs := validate.Var(spec.f, spec.t)
+		Equal(t, errs, nil)
+	}
+
+	failSpecs := []struct {
+		f interface{}
+		t string
+	}{
+		{f: "", t: "oneofci=red green"},
+		{f: "Yellow", t: "oneofci=red green"},
+		{f: "REDS", t: "oneofci=red green"},
+		{f: "dark", t: "oneofci='Dark Red' blue"},
+	}
+
+	for _, spec := range failSpecs {
+		t.Logf("%#v", spec)
+		errs := validate.Var(spec.f, spec.t)
+		AssertError(t, errs, "", "", "", "", "oneofci")
+	}
+
+	// oneof remains case sensitive
+	errs := validate.Var("RED", "oneof=red green")
+	AssertError(t, errs, "", "", "", "", "oneof")
+
+	PanicMatches(t, func() {
+		_ = validate.Var(5, "oneofci=5 6")
+	}, "Bad field type int")
+}
+
 func TestBase64Validation(t *testing.T) {
 	validate := New()
 
@@ -5214,6 +5512,61 @@ func TestFileValidation(t *testing.T) {
 	}, "Bad field type int")
 }
 
+func TestEthereumAddressChecksumValidation(t *testing.T) {
+	validate := New()
+
+	tests := []struct {
+		param    string
+		expected bool
+	}{
+		// EIP-55 test vectors.
+		{"0x52908400098527886E0F7030069857D2E4169EE7", true},
+		{"0x8617E340B3D01FA5F11F306F4090FD50E238070D", true},
+		{"0xde709f2102306220921060314715629080e2fb77", true},
+		{"0x27b1fdb04752bbc536007a920d24acb045561c26", true},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true},
+		{"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", true},
+		{"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", true},
+		{"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb", true},
+
+		// No checksum.
+		{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", false},
+		{"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", false},
+		{"0x123f681646d4a755815f9cb19e1acc8565a0c2ac", false},
+
+		// Invalid checksum.
+		{"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDB", false},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", false},
+
+		// Other.
+		{"", false},
+		{"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAe", false},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAedd", false},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", false},
+	}
+
+	for i, test := range tests {
+
+		errs := validate.Var(test.param, "eth_addr_checksum")
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d eth_addr_checksum failed Error: %s", i, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d eth_addr_checksum failed Error: %s", i, errs)
+			} else {
+				val := getError(errs, "", "")
+				if val.Tag() != "eth_addr_checksum" {
+					t.Fatalf("Index: %d eth_addr_checksum failed Error: %s", i, errs)
+				}
+			}
+		}
+	}
+}
+
 func TestEthereumAddressValidation(t *testing.T) {
 	validate := New()
 
@@ -6978,6 +7331,86 @@ func TestMinMaxValidation(t *testing.T) {
 	Equal(t, errs, nil)
 }
 
+type stringerStatus int
+
+func (s stringerStatus) String() string {
+	switch s {
+	case 1:
+		return "active"
+	case 2:
+		return "deactivated_by_admin"
+	}
+	return ""
+}
+
+type stringerFlag struct {
+	On bool
+}
+
+func (f *stringerFlag) String() string {
+	if f.On {
+		return "on"
+	}
+	return "off"
+}
+
+func TestRegisterStringerLength(t *testing.T) {
+	type Account struct {
+		Status  stringerStatus  `validate:"min=1,max=10"`
+		Flag    stringerFlag    `validate:"len=2"`
+		Pointer *stringerStatus `validate:"omitempty,max=10"`
+		Timeout time.Duration   `validate:"max=1m"`
+		Name    string          `validate:"max=10"`
+	}
+
+	validate := New()
+
+	// by default the numeric value is validated
+	errs := validate.Var(stringerStatus(2), "max=10")
+	Equal(t, errs, nil)
+
+	validate.RegisterStringerLength()
+	validate.RegisterOpaqueType(stringerFlag{})
+
+	errs = validate.Var(stringerStatus(2), "max=10")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "max")
+
+	errs = validate.Var(stringerStatus(1), "max=10")
+	Equal(t, errs, nil)
+
+	status := stringerStatus(2)
+	a := &Account{Status: 1, Flag: stringerFlag{On: true}, Pointer: &status, Timeout: time.Second, Name: "joey"}
+
+	errs = validate.Struct(a)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "Account.Pointer", "Account.Pointer", "Pointer", "Pointer", "max")
+
+	a.Status = 0
+	a.Flag.On = false
+	a.Pointer = nil
+
+	errs = validate.Struct(a)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "Account.Status", "Account.Status", "Status", "Status", "min")
+	AssertError(t, errs, "Account.Flag", "Account.Flag", "Flag", "Flag", "len")
+
+	// durations keep their own comparison
+	a.Status = 1
+	a.Flag.On = true
+	a.Timeout = time.Hour
+
+	errs = validate.Struct(a)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "Account.Timeout", "Account.Timeout", "Timeout", "Timeout", "max")
+
+	Equal(t, validate.ValidateTagForType("len=2", reflect.TypeOf(stringerFlag{})), nil)
+	NotEqual(t, New().ValidateTagForType("len=2", reflect.TypeOf(stringerFlag{})), nil)
+}
+
 func TestLenValidation(t *testing.T) {
 	var errs error
 	validate := New()
@@ -7825,6 +8258,58 @@ func TestNumber(t *testing.T) {
 	Equal(t, errs, nil)
 }
 
+func TestIntegerDecimalKinds(t *testing.T) {
+	validate := New()
+
+	var i8 int8 = -3
+	var u uint64 = 7
+	var f32 float32 = 1.5
+	var ptr = &i8
+
+	for _, v := range []interface{}{1, i8, u, uintptr(9), ptr} {
+		errs := validate.Var(v, "integer")
+		Equal(t, errs, nil)
+
+		errs = validate.Var(v, "decimal")
+		NotEqual(t, errs, nil)
+		AssertError(t, errs, "", "", "", "", "decimal")
+	}
+
+	for _, v := range []interface{}{f32, 2.0} {
+		errs := validate.Var(v, "decimal")
+		Equal(t, errs, nil)
+
+		errs = validate.Var(v, "integer")
+		NotEqual(t, errs, nil)
+		AssertError(t, errs, "", "", "", "", "integer")
+	}
+
+	// numeric strings pass numeric and number but not the kind validators
+	for _, v := range []interface{}{"42", "1.5", true} {
+		errs := validate.Var(v, "integer")
+		NotEqual(t, errs, nil)
+		AssertError(t, errs, "", "", "", "", "integer")
+
+		errs = validate.Var(v, "decimal")
+		NotEqual(t, errs, nil)
+		AssertError(t, errs, "", "", "", "", "decimal")
+	}
+
+	errs := validate.Var("42", "numeric")
+	Equal(t, errs, nil)
+
+	type Payload struct {
+		Count string  `validate:"integer"`
+		Ratio float64 `validate:"decimal"`
+		Size  int     `validate:"integer,min=1"`
+	}
+
+	errs = validate.Struct(Payload{Count: "42", Ratio: 0.5, Size: 2})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "Payload.Count", "Payload.Count", "Count", "Count", "integer")
+}
+
 func TestNumeric(t *testing.T) {
 	validate := New()
 
@@ -8199,7 +8684,7 @@ func TestInvalidStruct(t *testing.T) {
 
 	err := validate.Struct(s.Test)
 	NotEqual(t, err, nil)
-	Equal(t, err.Error(), "validator: (nil string)")
+	Equal(t, err.Error(), "validator: expected a struct but got string of kind string, use Var to validate a single variable")
 
 	err = validate.Struct(nil)
 	NotEqual(t, err, nil)
@@ -8381,6 +8866,51 @@ func TestBoolEqual(t *testing.T) {
 	Equal(t, err, nil)
 }
 
+func TestValidationErrorsTranslateMap(t *testing.T) {
+	en := en.New()
+	uni := ut.New(en, en)
+	trans, _ := uni.GetTranslator("en")
+
+	validate := New()
+
+	for tag, text := range map[string]string{
+		"required": "{0} is a required field",
+		"email":    "{0} must be a valid email address",
+	} {
+		text := text
+		err := validate.RegisterTranslation(tag, trans,
+			func(ut ut.Translator) error {
+				return ut.Add(tag, text, false)
+			}, func(ut ut.Translator, fe FieldError) string {
+				t, _ := ut.T(fe.Tag(), fe.Field())
+				return t
+			})
+		Equal(t, err, nil)
+	}
+
+	type Address struct {
+		City string `validate:"required"`
+	}
+
+	type User struct {
+		Name    string `validate:"required"`
+		Email   string `validate:"email"`
+		Age     int    `validate:"gte=18"`
+		Address Address
+	}
+
+	err := validate.Struct(User{Email: "joeybloggs", Age: 16})
+	NotEqual(t, err, nil)
+
+	m := err.(ValidationErrors).Translate(trans)
+	Equal(t, len(m), 4)
+	Equal(t, m["User.Name"], "Name is a required field")
+	Equal(t, m["User.Email"], "Email must be a valid email address")
+	Equal(t, m["User.Address.City"], "City is a required field")
+	// unregistered tags fall back to the untranslated message
+	Equal(t, m["User.Age"], "Key: 'User.Age' Error:Field validation for 'Age' failed on the 'gte' tag")
+}
+
 func TestTranslations(t *testing.T) {
 	en := en.New()
 	uni := ut.New(en, en, fr.New())
@@ -8676,7 +9206,7 @@ func TestStructFiltered(t *testing.T) {
 	dt := time.Now()
 	err := validate.StructFiltered(&dt, func(ns []byte) bool { return true })
 	NotEqual(t, err, nil)
-	Equal(t, err.Error(), "validator: (nil *time.Time)")
+	Equal(t, err.Error(), "validator: *time.Time is validated as a value rather than by its fields, use Var to validate a single variable")
 }
 
 func TestRequiredPtr(t *testing.T) {
@@ -9197,6 +9727,20 @@ func TestFQDNValidation(t *testing.T) {
 		{"2001:cdba:0:0:0:0:3257:9652", false},
 		{"2001:cdba::3257:9652", false},
 		{"", false},
+		{".", false},
+		{".example.com", false},
+		{"example..com", false},
+		{"_sip._tcp.example.com", false},
+		{"under_score.example.com", false},
+		{"xn--mnchen-3ya.de", true},
+		{"xn--mnchen-3ya.de.", true},
+		{"münchen.de", false},
+		{"-example.com", false},
+		{strings.Repeat("a", 63) + ".com", true},
+		{strings.Repeat("a", 64) + ".com", false},
+		{strings.Repeat(strings.Repeat("a", 49)+".", 5) + "com", true},
+		{strings.Repeat(strings.Repeat("a", 49)+".", 5) + "com.", true},
+		{strings.Repeat(strings.Repeat("a", 49)+".", 5) + "comm", false},
 	}
 
 	validate := New()
@@ -9222,10 +9766,73 @@ func TestFQDNValidation(t *testing.T) {
 	}
 }
 
-func TestIsDefault(t *testing.T) {
+func TestFQDNRelaxedValidation(t *testing.T) {
+	tests := []struct {
+		param    string
+		expected bool
+	}{
+		{"test.example.com", true},
+		{"test.example.com.", true},
+		{"_sip._tcp.example.com", true},
+		{"under_score.example.com", true},
+		{"example", false},
+		{"example._com", false},
+		{"example..com", false},
+		{"", false},
+		{strings.Repeat("a", 64) + ".com", false},
+		{strings.Repeat(strings.Repeat("_", 49)+".", 5) + "comm", false},
+	}
+
 	validate := New()
 
-	type Inner struct {
+	for i, test := range tests {
+
+		errs := validate.Var(test.param, "fqdn_relaxed")
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d fqdn_relaxed failed Error: %v", i, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d fqdn_relaxed failed Error: %v", i, errs)
+			} else {
+				val := getError(errs, "", "")
+				if val.Tag() != "fqdn_relaxed" {
+					t.Fatalf("Index: %d fqdn_relaxed failed Error: %v", i, errs)
+				}
+			}
+		}
+	}
+}
+
+func TestHostnameLengthValidation(t *testing.T) {
+	validate := New()
+
+	long := strings.Repeat(strings.Repeat("a", 63)+".", 3) + strings.Repeat("a", 61)
+	Equal(t, len(long), 253)
+
+	for _, tag := range []string{"hostname", "hostname_rfc1123"} {
+		errs := validate.Var(long, tag)
+		Equal(t, errs, nil)
+
+		errs = validate.Var(long+"a", tag)
+		NotEqual(t, errs, nil)
+		AssertError(t, errs, "", "", "", "", tag)
+
+		errs = validate.Var("a"+strings.Repeat("b", 63), tag)
+		NotEqual(t, errs, nil)
+		AssertError(t, errs, "", "", "", "", tag)
+
+		errs = validate.Var("a"+strings.Repeat("b", 62), tag)
+		Equal(t, errs, nil)
+	}
+}
+
+func TestIsDefault(t *testing.T) {
+	validate := New()
+
+	type Inner struct {
 		String string `validate:"isdefault"`
 	}
 	type Test struct {
@@ -9565,6 +10172,89 @@ func TestURLEncodedValidation(t *testing.T) {
 	}
 }
 
+func TestVarMapKeysAndValues(t *testing.T) {
+	validate := New(WithSortedMapKeys())
+
+	counts := map[string]int{"a": 1, "bb": 0, "cc": 3, "d": 0}
+
+	errs := validate.Var(counts, "gt=0,dive,keys,min=2,endkeys,required")
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 4)
+
+	// sorted by key, the key's error before its value's
+	Equal(t, ve[0].Namespace(), "[a]")
+	Equal(t, ve[0].Tag(), "min")
+	Equal(t, ve[0].Value(), "a")
+	Equal(t, ve[1].Namespace(), "[bb]")
+	Equal(t, ve[1].Tag(), "required")
+	Equal(t, ve[1].Value(), 0)
+	Equal(t, ve[2].Namespace(), "[d]")
+	Equal(t, ve[2].Tag(), "min")
+	Equal(t, ve[3].Namespace(), "[d]")
+	Equal(t, ve[3].Tag(), "required")
+	Equal(t, ve[3].Field(), "[d]")
+
+	errs = validate.Var(map[string]int{"bb": 2, "cc": 3}, "gt=0,dive,keys,min=2,endkeys,required")
+	Equal(t, errs, nil)
+
+	// the map's own tags apply before diving
+	errs = validate.Var(map[string]int{}, "gt=0,dive,keys,min=2,endkeys,required")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "gt")
+
+	validate.SetNamespaceBrackets("/", "")
+
+	errs = validate.Var(map[string]int{"a": 1}, "dive,keys,min=2,endkeys")
+	NotEqual(t, errs, nil)
+	Equal(t, errs.(ValidationErrors)[0].Namespace(), "/a")
+}
+
+type diveConfig struct {
+	Port int `validate:"min=1"`
+	Name string
+}
+
+func TestDivePointerStructElements(t *testing.T) {
+	validate := New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		c := sl.Current().Interface().(diveConfig)
+		if len(c.Name) == 0 {
+			sl.ReportError(c.Name, "Name", "Name", "named", "")
+		}
+	}, diveConfig{})
+
+	type Server struct {
+		Configs  map[string]*diveConfig `validate:"dive"`
+		Required map[string]*diveConfig `validate:"dive,required"`
+		List     []*diveConfig          `validate:"dive"`
+	}
+
+	s := Server{
+		Configs:  map[string]*diveConfig{"backup": nil, "primary": {Port: 0, Name: "p"}, "replica": {Port: 2}},
+		Required: map[string]*diveConfig{"a": nil, "b": {Port: 1, Name: "b"}},
+		List:     []*diveConfig{nil, {Port: 1, Name: "l"}},
+	}
+
+	errs := validate.Struct(s)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	AssertError(t, errs, "Server.Configs[primary].Port", "Server.Configs[primary].Port", "Port", "Port", "min")
+	AssertError(t, errs, "Server.Configs[replica].Name", "Server.Configs[replica].Name", "Name", "Name", "named")
+	AssertError(t, errs, "Server.Required[a]", "Server.Required[a]", "Required[a]", "Required[a]", "required")
+
+	errs = validate.Var(map[string]*diveConfig{"a": nil, "b": {}}, "dive")
+	NotEqual(t, errs, nil)
+
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	Equal(t, ve[0].Namespace(), "[b].Port")
+	Equal(t, ve[1].Namespace(), "[b].Name")
+}
+
 func TestKeys(t *testing.T) {
 	type Test struct {
 		Test1 map[string]string `validate:"gt=0,dive,keys,eq=testkey,endkeys,eq=testval" json:"test1"`
@@ -9900,828 +10590,5804 @@ func TestEndsWithValidation(t *testing.T) {
 	}
 }
 
-func TestRequiredIf(t *testing.T) {
-	type Inner struct {
-		Field *string
+func TestContainsFieldValidation(t *testing.T) {
+	validate := New()
+
+	type Post struct {
+		Title       string   `validate:"containsfield=Keyword"`
+		Tags        []string `validate:"containsfield=RequiredTag"`
+		IDs         []int64  `validate:"containsfield=OwnerID"`
+		Keyword     string
+		RequiredTag string
+		OwnerID     int32
 	}
 
-	fieldVal := "test"
-	test := struct {
-		Inner   *Inner
-		FieldE  string            `validate:"omitempty" json:"field_e"`
-		FieldER string            `validate:"required_if=FieldE test" json:"field_er"`
-		Field1  string            `validate:"omitempty" json:"field_1"`
-		Field2  *string           `validate:"required_if=Field1 test" json:"field_2"`
-		Field3  map[string]string `validate:"required_if=Field2 test" json:"field_3"`
-		Field4  interface{}       `validate:"required_if=Field3 1" json:"field_4"`
-		Field5  int               `validate:"required_if=Inner.Field test" json:"field_5"`
-		Field6  uint              `validate:"required_if=Field5 1" json:"field_6"`
-		Field7  float32           `validate:"required_if=Field6 1" json:"field_7"`
-		Field8  float64           `validate:"required_if=Field7 1.0" json:"field_8"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: 2,
+	p := Post{
+		Title:       "validating structs in go",
+		Tags:        []string{"go", "validation"},
+		IDs:         []int64{1, 7},
+		Keyword:     "structs",
+		RequiredTag: "go",
+		OwnerID:     7,
 	}
 
-	validate := New()
+	errs := validate.Struct(p)
+	Equal(t, errs, nil)
 
-	errs := validate.Struct(test)
+	p.Keyword = "maps"
+	p.RequiredTag = "rust"
+	p.OwnerID = 3
+
+	errs = validate.Struct(p)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "Post.Title", "Post.Title", "Title", "Title", "containsfield")
+	AssertError(t, errs, "Post.Tags", "Post.Tags", "Tags", "Tags", "containsfield")
+	AssertError(t, errs, "Post.IDs", "Post.IDs", "IDs", "IDs", "containsfield")
+
+	// an empty slice never contains the needle
+	p = Post{Title: "go", Keyword: "go", RequiredTag: "go", IDs: []int64{0}}
+
+	errs = validate.Struct(p)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "Post.Tags", "Post.Tags", "Tags", "Tags", "containsfield")
+
+	errs = validate.VarWithValue([]string{"a", "b"}, "b", "containsfield")
 	Equal(t, errs, nil)
 
-	test2 := struct {
-		Inner   *Inner
-		Inner2  *Inner
-		FieldE  string            `validate:"omitempty" json:"field_e"`
-		FieldER string            `validate:"required_if=FieldE test" json:"field_er"`
-		Field1  string            `validate:"omitempty" json:"field_1"`
-		Field2  *string           `validate:"required_if=Field1 test" json:"field_2"`
-		Field3  map[string]string `validate:"required_if=Field2 test" json:"field_3"`
-		Field4  interface{}       `validate:"required_if=Field2 test" json:"field_4"`
-		Field5  string            `validate:"required_if=Field3 1" json:"field_5"`
-		Field6  string            `validate:"required_if=Inner.Field test" json:"field_6"`
-		Field7  string            `validate:"required_if=Inner2.Field test" json:"field_7"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field2: &fieldVal,
+	errs = validate.VarWithValue("abc", "bc", "containsfield")
+	Equal(t, errs, nil)
+
+	errs = validate.VarWithValue("abc", 1, "containsfield")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "containsfield")
+
+	type Missing struct {
+		Tags []string `validate:"containsfield=RequiredTag"`
 	}
 
-	errs = validate.Struct(test2)
+	errs = validate.Struct(Missing{Tags: []string{"go"}})
 	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Missing.Tags", "Missing.Tags", "Tags", "Tags", "containsfield")
 
-	ve := errs.(ValidationErrors)
-	Equal(t, len(ve), 3)
-	AssertError(t, errs, "Field3", "Field3", "Field3", "Field3", "required_if")
-	AssertError(t, errs, "Field4", "Field4", "Field4", "Field4", "required_if")
-	AssertError(t, errs, "Field6", "Field6", "Field6", "Field6", "required_if")
+	validate.SetStrictCrossField(true)
 
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("test3 should have panicked!")
-		}
-	}()
+	errs = validate.Struct(Missing{Tags: []string{"go"}})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Missing.Tags", "Missing.Tags", "Tags", "Tags", "_badcrossfield")
 
-	test3 := struct {
-		Inner  *Inner
-		Field1 string `validate:"required_if=Inner.Field" json:"field_1"`
-	}{
-		Inner: &Inner{Field: &fieldVal},
-	}
-	_ = validate.Struct(test3)
+	PanicMatches(t, func() { _ = validate.VarWithValue(1, 1, "containsfield") }, "Bad field type int")
 }
 
-func TestRequiredUnless(t *testing.T) {
-	type Inner struct {
-		Field *string
+func TestStructCoerce(t *testing.T) {
+	validate := New()
+
+	type Paging struct {
+		Page    string `validate:"omitempty,numeric,coerce=PageNum"`
+		PageNum *uint  `validate:"omitempty,min=1"`
 	}
 
-	fieldVal := "test"
-	test := struct {
-		Inner   *Inner
-		FieldE  string            `validate:"omitempty" json:"field_e"`
-		FieldER string            `validate:"required_unless=FieldE test" json:"field_er"`
-		Field1  string            `validate:"omitempty" json:"field_1"`
-		Field2  *string           `validate:"required_unless=Field1 test" json:"field_2"`
-		Field3  map[string]string `validate:"required_unless=Field2 test" json:"field_3"`
-		Field4  interface{}       `validate:"required_unless=Field3 1" json:"field_4"`
-		Field5  int               `validate:"required_unless=Inner.Field test" json:"field_5"`
-		Field6  uint              `validate:"required_unless=Field5 2" json:"field_6"`
-		Field7  float32           `validate:"required_unless=Field6 0" json:"field_7"`
-		Field8  float64           `validate:"required_unless=Field7 0.0" json:"field_8"`
-		Field9  bool              `validate:"omitempty" json:"field_9"`
-		Field10 string            `validate:"required_unless=Field9 true" json:"field_10"`
-	}{
-		FieldE: "test",
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: 2,
-		Field9: true,
+	type Query struct {
+		Limit    string  `validate:"numeric,coerce=LimitNum"`
+		LimitNum int     `validate:"min=1,max=100"`
+		Ratio    *string `validate:"omitempty,coerce=RatioNum"`
+		RatioNum float32 `validate:"lte=1"`
+		Paging   Paging
 	}
 
-	validate := New()
+	ratio := "0.25"
+	q := Query{Limit: "50", Ratio: &ratio, Paging: Paging{Page: "3"}}
 
-	errs := validate.Struct(test)
+	errs := validate.StructCoerce(&q)
 	Equal(t, errs, nil)
+	Equal(t, q.LimitNum, 50)
+	Equal(t, q.RatioNum, float32(0.25))
+	NotEqual(t, q.Paging.PageNum, nil)
+	Equal(t, *q.Paging.PageNum, uint(3))
 
-	test2 := struct {
-		Inner   *Inner
-		Inner2  *Inner
-		FieldE  string            `validate:"omitempty" json:"field_e"`
-		FieldER string            `validate:"required_unless=FieldE test" json:"field_er"`
-		Field1  string            `validate:"omitempty" json:"field_1"`
-		Field2  *string           `validate:"required_unless=Field1 test" json:"field_2"`
-		Field3  map[string]string `validate:"required_unless=Field2 test" json:"field_3"`
-		Field4  interface{}       `validate:"required_unless=Field2 test" json:"field_4"`
-		Field5  string            `validate:"required_unless=Field3 0" json:"field_5"`
-		Field6  string            `validate:"required_unless=Inner.Field test" json:"field_6"`
-		Field7  string            `validate:"required_unless=Inner2.Field test" json:"field_7"`
-		Field8  bool              `validate:"omitempty" json:"field_8"`
-		Field9  string            `validate:"required_unless=Field8 true" json:"field_9"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		FieldE: "test",
-		Field1: "test",
-	}
+	q = Query{Limit: "500", Paging: Paging{Page: "0"}}
 
-	errs = validate.Struct(test2)
+	errs = validate.StructCoerce(&q)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	Equal(t, q.LimitNum, 500)
+	AssertError(t, errs, "Query.LimitNum", "Query.LimitNum", "LimitNum", "LimitNum", "max")
+	AssertError(t, errs, "Query.Paging.PageNum", "Query.Paging.PageNum", "PageNum", "PageNum", "min")
+
+	// strings which don't parse leave the target untouched
+	q = Query{Limit: "ten", LimitNum: 7}
+
+	errs = validate.StructCoerce(&q)
 	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	Equal(t, q.LimitNum, 7)
+	AssertError(t, errs, "Query.Limit", "Query.Limit", "Limit", "Limit", "numeric")
 
-	ve := errs.(ValidationErrors)
-	Equal(t, len(ve), 4)
-	AssertError(t, errs, "Field3", "Field3", "Field3", "Field3", "required_unless")
-	AssertError(t, errs, "Field4", "Field4", "Field4", "Field4", "required_unless")
-	AssertError(t, errs, "Field7", "Field7", "Field7", "Field7", "required_unless")
-	AssertError(t, errs, "Field9", "Field9", "Field9", "Field9", "required_unless")
+	// Struct doesn't coerce
+	q = Query{Limit: "50"}
 
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("test3 should have panicked!")
-		}
-	}()
+	errs = validate.Struct(&q)
+	NotEqual(t, errs, nil)
+	Equal(t, q.LimitNum, 0)
+	AssertError(t, errs, "Query.LimitNum", "Query.LimitNum", "LimitNum", "LimitNum", "min")
 
-	test3 := struct {
-		Inner  *Inner
-		Field1 string `validate:"required_unless=Inner.Field" json:"field_1"`
-	}{
-		Inner: &Inner{Field: &fieldVal},
+	errs = validate.StructCoerce(q)
+	NotEqual(t, errs, nil)
+	Equal(t, IsInvalidValidationError(errs), true)
+	Equal(t, errs.Error(), "validator: StructCoerce requires a pointer to a struct but got validator.Query")
+
+	errs = validate.StructCoerce((*Query)(nil))
+	NotEqual(t, errs, nil)
+	Equal(t, errs.Error(), "validator: (nil *validator.Query)")
+
+	// pointers are dereferenced as for Struct
+	q = Query{Limit: "50", Ratio: &ratio, Paging: Paging{Page: "3"}}
+	pq := &q
+	errs = validate.StructCoerce(&pq)
+	Equal(t, errs, nil)
+	Equal(t, q.LimitNum, 50)
+
+	var nilQuery *Query
+	errs = validate.StructCoerce(&nilQuery)
+	NotEqual(t, errs, nil)
+	Equal(t, errs.Error(), "validator: (nil **validator.Query)")
+
+	errs = validate.StructCoerce(&time.Time{})
+	NotEqual(t, errs, nil)
+	Equal(t, errs.Error(), "validator: *time.Time is validated as a value rather than by its fields, use Var to validate a single variable")
+
+	type BadTarget struct {
+		Limit string `validate:"coerce=Missing"`
 	}
-	_ = validate.Struct(test3)
-}
 
-func TestRequiredWith(t *testing.T) {
-	type Inner struct {
-		Field *string
+	PanicMatches(t, func() { _ = validate.StructCoerce(&BadTarget{Limit: "1"}) }, "Undefined coerce target 'Missing' on field 'Limit'")
+
+	type BadKind struct {
+		Limit string `validate:"coerce=Name"`
+		Name  string
 	}
 
-	fieldVal := "test"
-	test := struct {
-		Inner   *Inner
-		FieldE  string            `validate:"omitempty" json:"field_e"`
-		FieldER string            `validate:"required_with=FieldE" json:"field_er"`
-		Field1  string            `validate:"omitempty" json:"field_1"`
-		Field2  *string           `validate:"required_with=Field1" json:"field_2"`
-		Field3  map[string]string `validate:"required_with=Field2" json:"field_3"`
-		Field4  interface{}       `validate:"required_with=Field3" json:"field_4"`
-		Field5  string            `validate:"required_with=Inner.Field" json:"field_5"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
+	PanicMatches(t, func() { _ = validate.StructCoerce(&BadKind{Limit: "1"}) }, "Bad coerce target type string on field 'Limit'")
+}
+
+func TestAddTagName(t *testing.T) {
+	type User struct {
+		Name    string `validate:"required" binding:"max=5"`
+		Email   string `binding:"omitempty,email"`
+		Age     int    `validate:"min=18" binding:"-"`
+		Ignored string `validate:"-" binding:"required"`
+		Both    string `validate:"omitempty,min=2" binding:"max=3"`
 	}
 
 	validate := New()
+	validate.AddTagName("binding")
+	validate.AddTagName("binding")
+	validate.AddTagName("validate")
 
-	errs := validate.Struct(test)
+	Equal(t, len(validate.extraTagNames), 1)
+
+	errs := validate.Struct(User{Name: "joey", Age: 18})
 	Equal(t, errs, nil)
 
-	test2 := struct {
-		Inner   *Inner
-		Inner2  *Inner
-		FieldE  string            `validate:"omitempty" json:"field_e"`
-		FieldER string            `validate:"required_with=FieldE" json:"field_er"`
-		Field1  string            `validate:"omitempty" json:"field_1"`
-		Field2  *string           `validate:"required_with=Field1" json:"field_2"`
-		Field3  map[string]string `validate:"required_with=Field2" json:"field_3"`
-		Field4  interface{}       `validate:"required_with=Field2" json:"field_4"`
-		Field5  string            `validate:"required_with=Field3" json:"field_5"`
-		Field6  string            `validate:"required_with=Inner.Field" json:"field_6"`
-		Field7  string            `validate:"required_with=Inner2.Field" json:"field_7"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field2: &fieldVal,
-	}
+	errs = validate.Struct(User{Name: "joeybloggs", Email: "joey", Age: 17, Both: "abcd"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 4)
+	AssertError(t, errs, "User.Name", "User.Name", "Name", "Name", "max")
+	AssertError(t, errs, "User.Email", "User.Email", "Email", "Email", "email")
+	AssertError(t, errs, "User.Age", "User.Age", "Age", "Age", "min")
+	AssertError(t, errs, "User.Both", "User.Both", "Both", "Both", "max")
 
-	errs = validate.Struct(test2)
+	// the primary tag's rules run first
+	errs = validate.Struct(User{Age: 18})
 	NotEqual(t, errs, nil)
+	AssertError(t, errs, "User.Name", "User.Name", "Name", "Name", "required")
 
-	ve := errs.(ValidationErrors)
-	Equal(t, len(ve), 3)
-	AssertError(t, errs, "Field3", "Field3", "Field3", "Field3", "required_with")
-	AssertError(t, errs, "Field4", "Field4", "Field4", "Field4", "required_with")
-	AssertError(t, errs, "Field6", "Field6", "Field6", "Field6", "required_with")
+	rules := validate.StructRules(User{})
+	Equal(t, rules["User.Name"], []Rule{{Tag: "required"}, {Tag: "max", Param: "5"}})
+	Equal(t, rules["User.Both"], []Rule{{Tag: "omitempty"}, {Tag: "min", Param: "2"}, {Tag: "max", Param: "3"}})
+
+	// without the additional tag only the primary rules apply
+	errs = New().Struct(User{Name: "joeybloggs", Email: "joey", Age: 18})
+	Equal(t, errs, nil)
 }
 
-func TestExcludedWith(t *testing.T) {
-	type Inner struct {
-		FieldE string
-		Field  *string
+func TestSetTypeMaxDepth(t *testing.T) {
+	type Tree struct {
+		Name     string  `validate:"required"`
+		Children []*Tree `validate:"dive"`
+		Left     *Tree
 	}
 
-	fieldVal := "test"
-	test := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_with=FieldE" json:"field_1"`
-		Field2 *string           `validate:"excluded_with=FieldE" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_with=FieldE" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_with=FieldE" json:"field_4"`
-		Field5 string            `validate:"excluded_with=Inner.FieldE" json:"field_5"`
-		Field6 string            `validate:"excluded_with=Inner2.FieldE" json:"field_6"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field1: fieldVal,
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
-		Field6: "test",
+	leaf := func(name string) *Tree { return &Tree{Name: name} }
+
+	// depth 3: root, child and grandchild
+	tree := &Tree{
+		Name: "root",
+		Children: []*Tree{
+			{Name: "a", Children: []*Tree{leaf("a1")}},
+		},
+		Left: &Tree{Name: "l", Left: leaf("ll")},
 	}
 
 	validate := New()
+	validate.SetTypeMaxDepth(&Tree{}, 3)
 
-	errs := validate.Struct(test)
+	errs := validate.Struct(tree)
 	Equal(t, errs, nil)
 
-	test2 := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_with=Field" json:"field_1"`
-		Field2 *string           `validate:"excluded_with=Field" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_with=Field" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_with=Field" json:"field_4"`
-		Field5 string            `validate:"excluded_with=Inner.Field" json:"field_5"`
-		Field6 string            `validate:"excluded_with=Inner2.Field" json:"field_6"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field:  "populated",
-		Field1: fieldVal,
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
-		Field6: "test",
-	}
+	tree.Children[0].Children[0].Children = []*Tree{leaf("a11")}
+	tree.Left.Left.Left = &Tree{}
 
-	errs = validate.Struct(test2)
+	errs = validate.Struct(tree)
 	NotEqual(t, errs, nil)
 
 	ve := errs.(ValidationErrors)
-	Equal(t, len(ve), 5)
-	for i := 1; i <= 5; i++ {
-		name := fmt.Sprintf("Field%d", i)
-		AssertError(t, errs, name, name, name, name, "excluded_with")
-	}
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Tree.Children[0].Children[0].Children[0]", "Tree.Children[0].Children[0].Children[0]", "Children[0]", "Children[0]", "_maxdepth")
+	AssertError(t, errs, "Tree.Left.Left.Left", "Tree.Left.Left.Left", "Left", "Left", "_maxdepth")
+	Equal(t, ve[0].Param(), "3")
 
-	test3 := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_with=FieldE" json:"field_1"`
-		Field2 *string           `validate:"excluded_with=FieldE" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_with=FieldE" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_with=FieldE" json:"field_4"`
-		Field5 string            `validate:"excluded_with=Inner.FieldE" json:"field_5"`
-		Field6 string            `validate:"excluded_with=Inner2.FieldE" json:"field_6"`
-	}{
-		Inner:  &Inner{FieldE: "populated"},
-		Inner2: &Inner{FieldE: "populated"},
-		FieldE: "populated",
-	}
+	// without the cap the empty, too deep, node is validated
+	errs = New().Struct(tree)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Tree.Left.Left.Left.Name", "Tree.Left.Left.Left.Name", "Name", "Name", "required")
 
-	validate = New()
+	validate.SetTypeMaxDepth(Tree{}, 0)
 
-	errs = validate.Struct(test3)
-	Equal(t, errs, nil)
+	errs = validate.Struct(tree)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Tree.Left.Left.Left.Name", "Tree.Left.Left.Left.Name", "Name", "Name", "required")
+
+	PanicMatches(t, func() { validate.SetTypeMaxDepth(1, 3) }, "SetTypeMaxDepth requires a struct sample, got int")
 }
 
-func TestExcludedWithout(t *testing.T) {
-	type Inner struct {
-		FieldE string
-		Field  *string
+func TestValidateTagForType(t *testing.T) {
+	validate := New()
+
+	intType := reflect.TypeOf(0)
+
+	err := validate.ValidateTagForType("required,email", intType)
+	NotEqual(t, err, nil)
+
+	tke, ok := err.(*TagKindError)
+	Equal(t, ok, true)
+	Equal(t, tke.Tag, "email")
+	Equal(t, tke.Type == intType, true)
+	Equal(t, err.Error(), "validator: tag 'email' doesn't support int of kind int")
+
+	Equal(t, validate.ValidateTagForType("required,email", reflect.TypeOf("")), nil)
+	Equal(t, validate.ValidateTagForType("omitempty,email", reflect.TypeOf(new(*string))), nil)
+	Equal(t, validate.ValidateTagForType("min=1,max=5", intType), nil)
+	Equal(t, validate.ValidateTagForType("gt", reflect.TypeOf(time.Time{})), nil)
+	Equal(t, validate.ValidateTagForType("eq=true", reflect.TypeOf(true)), nil)
+
+	err = validate.ValidateTagForType("min=5", reflect.TypeOf(true))
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: tag 'min' doesn't support bool of kind bool")
+
+	// dives check the element and key types
+	Equal(t, validate.ValidateTagForType("min=1,dive,email", reflect.TypeOf([]string{})), nil)
+
+	err = validate.ValidateTagForType("min=1,dive,email", reflect.TypeOf([]int{}))
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: tag 'email' doesn't support int of kind int")
+
+	err = validate.ValidateTagForType("dive,keys,alpha,endkeys,min=1", reflect.TypeOf(map[int]int{}))
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: tag 'alpha' doesn't support int of kind int")
+
+	Equal(t, validate.ValidateTagForType("dive,keys,alpha,endkeys,min=1", reflect.TypeOf(map[string]int{})), nil)
+
+	// or'd and aliased tags are checked too
+	err = validate.ValidateTagForType("uuid|email", intType)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: tag 'uuid' doesn't support int of kind int")
+
+	validate.RegisterAlias("contact", "email")
+
+	err = validate.ValidateTagForType("contact", intType)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: tag 'email' doesn't support int of kind int")
+
+	// kinds only known while validating aren't checked
+	Equal(t, validate.ValidateTagForType("email", reflect.TypeOf((*interface{})(nil)).Elem()), nil)
+
+	validate.RegisterCustomTypeFunc(ValidateValuerType, valuer{})
+	Equal(t, validate.ValidateTagForType("email", reflect.TypeOf(valuer{})), nil)
+
+	// custom validations support any type
+	err = validate.RegisterValidation("even", func(fl FieldLevel) bool { return fl.Field().Int()%2 == 0 })
+	Equal(t, err, nil)
+	Equal(t, validate.ValidateTagForType("even", reflect.TypeOf(true)), nil)
+
+	err = validate.ValidateTagForType("undefinedtag", intType)
+	NotEqual(t, err, nil)
+	Equal(t, strings.HasPrefix(err.Error(), "validator: Undefined validation function 'undefinedtag'"), true)
+
+	err = validate.ValidateTagForType("email", nil)
+	NotEqual(t, err, nil)
+	Equal(t, IsInvalidValidationError(err), true)
+
+	for tag := range bakedInKinds {
+		_, ok = bakedInValidators[tag]
+		Equal(t, ok, true)
 	}
+}
 
-	fieldVal := "test"
-	test := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_without=Field" json:"field_1"`
-		Field2 *string           `validate:"excluded_without=Field" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_without=Field" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_without=Field" json:"field_4"`
-		Field5 string            `validate:"excluded_without=Inner.Field" json:"field_5"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field:  "populated",
-		Field1: fieldVal,
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
+func TestBakedInKinds(t *testing.T) {
+	// the baked in validations supporting values of any kind, all others must be listed in bakedInKinds
+	anyKind := map[string]struct{}{
+		"required": {}, "required_if": {}, "required_if_any": {}, "required_unless": {}, "required_with": {},
+		"required_with_all": {}, "required_without": {}, "required_without_all": {}, "required_one_of": {},
+		"required_exactly_one_of": {}, "excluded_with": {}, "excluded_with_all": {}, "excluded_without": {},
+		"excluded_without_all": {}, "skip_if": {}, "skip_unless": {}, "isdefault": {}, "notblank": {},
+		"eqfield": {}, "nefield": {}, "gtfield": {}, "gtefield": {}, "ltfield": {}, "ltefield": {},
+		"eqcsfield": {}, "necsfield": {}, "gtcsfield": {}, "gtecsfield": {}, "ltcsfield": {}, "ltecsfield": {},
+		"lenfield": {}, "ltlenfield": {}, "ltelenfield": {}, "gtlenfield": {}, "gtelenfield": {},
+		"fieldcontains": {}, "fieldexcludes": {}, "containsfield": {}, "coerce": {}, "integer": {}, "decimal": {},
+		"method": {},
+	}
+
+	for tag := range bakedInValidators {
+		_, restricted := bakedInKinds[tag]
+		_, any := anyKind[tag]
+		if restricted == any {
+			t.Fatalf("tag '%s' must be listed in bakedInKinds unless it supports values of any kind", tag)
+		}
+	}
+
+	params := map[string]string{
+		"len": "1", "min": "1", "max": "1", "gt": "1", "gte": "1", "lt": "1", "lte": "1", "eq": "1", "ne": "1",
+		"oneof": "1", "oneofci": "a", "rune_in": "a", "digits": "1", "digits_between": "1 2", "agemin": "1",
+		"agemax": "1", "datetime": "2006", "datetime_any": "2006", "postcode_iso3166_alpha2": "US",
+		"postcode_iso3166_alpha2_field": "Country", "inset": "codes", "contains": "a", "containsany": "a",
+		"containsrune": "a", "excludes": "a", "excludesall": "a", "excludesrune": "a", "startswith": "a",
+		"endswith": "a", "startsnotwith": "a", "endsnotwith": "a",
 	}
 
+	samples := []interface{}{"a", []byte("a"), 1, uint(1), 1.5, true, time.Now(), struct{ A int }{A: 1}, []int{1},
+		map[string]int{"a": 1}, [1]int{1}}
+
 	validate := New()
+	validate.RegisterDynamicSet("codes", func() map[string]struct{} { return map[string]struct{}{"a": {}} })
 
-	errs := validate.Struct(test)
-	Equal(t, errs, nil)
+	for tag, supports := range bakedInKinds {
+		for _, sample := range samples {
+			typ := reflect.TypeOf(sample)
 
-	test2 := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_without=FieldE" json:"field_1"`
-		Field2 *string           `validate:"excluded_without=FieldE" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_without=FieldE" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_without=FieldE" json:"field_4"`
-		Field5 string            `validate:"excluded_without=Inner.FieldE" json:"field_5"`
-		Field6 string            `validate:"excluded_without=Inner2.FieldE" json:"field_6"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field1: fieldVal,
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
-		Field6: "test",
+			if !supports(typ) {
+				NotEqual(t, validate.ValidateTagForType(tag, typ), nil)
+				continue
+			}
+
+			Equal(t, validate.ValidateTagForType(tag, typ), nil)
+
+			full := tag
+			if param, ok := params[tag]; ok {
+				full += "=" + param
+			}
+
+			func() {
+				defer func() {
+					if r := recover(); r != nil && strings.HasPrefix(fmt.Sprint(r), "Bad field type") {
+						t.Fatalf("tag '%s' supports %s but panics: %v", tag, typ, r)
+					}
+				}()
+				_ = validate.Var(sample, full)
+			}()
+		}
 	}
 
-	errs = validate.Struct(test2)
-	NotEqual(t, errs, nil)
+	NotEqual(t, validate.ValidateTagForType("rfc3339", reflect.TypeOf(0)), nil)
+	NotEqual(t, validate.ValidateTagForType("future", reflect.TypeOf("")), nil)
+	Equal(t, validate.ValidateTagForType("future", reflect.TypeOf(time.Time{})), nil)
+	Equal(t, validate.ValidateTagForType("email", reflect.TypeOf([]byte{})), nil)
+}
 
-	ve := errs.(ValidationErrors)
-	Equal(t, len(ve), 6)
-	for i := 1; i <= 6; i++ {
-		name := fmt.Sprintf("Field%d", i)
-		AssertError(t, errs, name, name, name, name, "excluded_without")
+func TestSetStrictTagKinds(t *testing.T) {
+	type Contact struct {
+		Email string `validate:"required,email"`
+		Phone int    `validate:"email"`
 	}
 
-	test3 := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_without=Field" json:"field_1"`
-		Field2 *string           `validate:"excluded_without=Field" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_without=Field" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_without=Field" json:"field_4"`
-		Field5 string            `validate:"excluded_without=Inner.Field" json:"field_5"`
-	}{
-		Inner: &Inner{Field: &fieldVal},
-		Field: "populated",
+	type Flags struct {
+		Active bool `validate:"min=5"`
 	}
 
+	validate := New()
+
+	errs := validate.Struct(Contact{Email: "joey@example.com", Phone: 1})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Contact.Phone", "Contact.Phone", "Phone", "Phone", "email")
+
 	validate = New()
+	validate.SetStrictTagKinds(true)
 
-	errs = validate.Struct(test3)
+	PanicMatches(t, func() { _ = validate.Struct(Contact{}) }, "validator: tag 'email' doesn't support int of kind int on field 'Phone'")
+	PanicMatches(t, func() { _ = validate.Struct(Flags{}) }, "validator: tag 'min' doesn't support bool of kind bool on field 'Active'")
+
+	type Valid struct {
+		Email string   `validate:"required,email"`
+		Tags  []string `validate:"max=3,dive,alpha"`
+	}
+
+	errs = validate.Struct(Valid{Email: "joey@example.com"})
 	Equal(t, errs, nil)
 }
 
-func TestExcludedWithAll(t *testing.T) {
-	type Inner struct {
-		FieldE string
-		Field  *string
+type deadlineRequest struct {
+	Name string `validate:"required"`
+	Wait time.Duration
+}
+
+func (r *deadlineRequest) Validate(ctx context.Context, sl StructLevel) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return
+	}
+	if time.Now().Add(r.Wait).After(deadline) {
+		sl.ReportError(r.Wait, "Wait", "Wait", "within_deadline", "")
 	}
+}
 
-	fieldVal := "test"
-	test := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_with_all=FieldE Field" json:"field_1"`
-		Field2 *string           `validate:"excluded_with_all=FieldE Field" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_with_all=FieldE Field" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_with_all=FieldE Field" json:"field_4"`
-		Field5 string            `validate:"excluded_with_all=Inner.FieldE" json:"field_5"`
-		Field6 string            `validate:"excluded_with_all=Inner2.FieldE" json:"field_6"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field:  fieldVal,
-		Field1: fieldVal,
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
-		Field6: "test",
+type selfValidatingRange struct {
+	Min int
+	Max int
+}
+
+func (r selfValidatingRange) Validate(sl StructLevel) {
+	if r.Min > r.Max {
+		sl.ReportError(r.Min, "Min", "Min", "lte_max", "")
 	}
+}
 
+func TestValidatableCtx(t *testing.T) {
 	validate := New()
 
-	errs := validate.Struct(test)
+	type Batch struct {
+		Request deadlineRequest
+		Range   selfValidatingRange
+		Ranges  []selfValidatingRange `validate:"dive"`
+		Skipped selfValidatingRange   `validate:"required,nostructlevel"`
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	b := &Batch{
+		Request: deadlineRequest{Name: "job", Wait: time.Second},
+		Range:   selfValidatingRange{Min: 1, Max: 2},
+		Ranges:  []selfValidatingRange{{Min: 1, Max: 1}},
+		Skipped: selfValidatingRange{Min: 9},
+	}
+
+	errs := validate.StructCtx(ctx, b)
 	Equal(t, errs, nil)
 
-	test2 := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_with_all=Field FieldE" json:"field_1"`
-		Field2 *string           `validate:"excluded_with_all=Field FieldE" json:"field_2"`
+	b.Request.Wait = time.Hour
+	b.Range.Max = 0
+	b.Ranges = append(b.Ranges, selfValidatingRange{Min: 3})
+
+	errs = validate.StructCtx(ctx, b)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "Batch.Request.Wait", "Batch.Request.Wait", "Wait", "Wait", "within_deadline")
+	AssertError(t, errs, "Batch.Range.Min", "Batch.Range.Min", "Min", "Min", "lte_max")
+	AssertError(t, errs, "Batch.Ranges[1].Min", "Batch.Ranges[1].Min", "Min", "Min", "lte_max")
+
+	// without a deadline the ctx variant has nothing to check
+	errs = validate.Struct(b)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+
+	// fields are validated before the struct validates itself
+	errs = validate.StructCtx(ctx, &deadlineRequest{Wait: time.Hour})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "deadlineRequest.Name", "deadlineRequest.Name", "Name", "Name", "required")
+	AssertError(t, errs, "deadlineRequest.Wait", "deadlineRequest.Wait", "Wait", "Wait", "within_deadline")
+	Equal(t, errs.(ValidationErrors)[1].Tag(), "within_deadline")
+
+	// pointer receivers can't be called on a struct passed by value
+	errs = validate.StructCtx(ctx, deadlineRequest{Name: "job", Wait: time.Hour})
+	Equal(t, errs, nil)
+}
+
+func TestRuneInValidation(t *testing.T) {
+	validate := New()
+
+	type Grade struct {
+		Code     string `validate:"rune_in=A-F"`
+		Category rune   `validate:"rune_in=A-Z0-9_"`
+		Flag     byte   `validate:"rune_in=-yn"`
+	}
+
+	errs := validate.Struct(Grade{Code: "B", Category: '7', Flag: 'y'})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Grade{Code: "G", Category: 'a', Flag: '-'})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "Grade.Code", "Grade.Code", "Code", "Code", "rune_in")
+	AssertError(t, errs, "Grade.Category", "Grade.Category", "Category", "Category", "rune_in")
+
+	tests := []struct {
+		value    interface{}
+		param    string
+		expected bool
+	}{
+		{"A", "A-Z0-9", true},
+		{"Z", "A-Z0-9", true},
+		{"5", "A-Z0-9", true},
+		{"a", "A-Z0-9", false},
+		{"", "A-Z0-9", false},
+		{"AB", "A-Z0-9", false},
+		{"é", "a-z", false},
+		{"é", "a-zà-ÿ", true},
+		{"_", "A-Z_", true},
+		{"-", "A-Z-", true},
+		{"-", "A-Z", false},
+		{"x", "xyz", true},
+		{'Q', "A-Z", true},
+		{uint8('q'), "A-Z", false},
+	}
+
+	for i, test := range tests {
+		errs := validate.Var(test.value, "rune_in="+test.param)
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d rune_in failed Error: %s", i, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d rune_in failed Error: %s", i, errs)
+			} else {
+				val := getError(errs, "", "")
+				if val.Tag() != "rune_in" {
+					t.Fatalf("Index: %d rune_in failed Error: %s", i, errs)
+				}
+			}
+		}
+	}
+
+	PanicMatches(t, func() { _ = validate.Var("A", "rune_in=Z-A") }, "Bad rune_in range 'Z-A'")
+	PanicMatches(t, func() { _ = validate.Var("A", "rune_in") }, "rune_in requires at least one character or range")
+	PanicMatches(t, func() { _ = validate.Var(1.5, "rune_in=A-Z") }, "Bad field type float64")
+}
+
+func TestSetOmitTopNamespace(t *testing.T) {
+	type Address struct {
+		Zip string `validate:"required"`
+	}
+
+	type User struct {
+		Email     string    `json:"email" validate:"required,email"`
+		Addresses []Address `json:"addresses" validate:"dive"`
+		Tags      []string  `json:"tags" validate:"dive,alpha"`
+	}
+
+	u := User{Email: "joey", Addresses: []Address{{Zip: "1"}, {}}, Tags: []string{"n0t"}}
+
+	validate := New()
+
+	errs := validate.Struct(u)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	Equal(t, ve[0].Namespace(), "User.Email")
+	Equal(t, ve[1].Namespace(), "User.Addresses[1].Zip")
+	Equal(t, ve[2].Namespace(), "User.Tags[0]")
+
+	validate.SetOmitTopNamespace(true)
+
+	errs = validate.Struct(u)
+	NotEqual(t, errs, nil)
+
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	Equal(t, ve[0].Namespace(), "Email")
+	Equal(t, ve[0].StructNamespace(), "Email")
+	Equal(t, ve[0].Field(), "Email")
+	Equal(t, ve[1].Namespace(), "Addresses[1].Zip")
+	Equal(t, ve[1].StructNamespace(), "Addresses[1].Zip")
+	Equal(t, ve[1].(*fieldError).PathSegments(), []string{"Addresses", "1", "Zip"})
+	Equal(t, ve[2].Namespace(), "Tags[0]")
+	Equal(t, ve[0].Error(), "Key: 'Email' Error:Field validation for 'Email' failed on the 'email' tag")
+
+	groups := ve.GroupByParent()
+	Equal(t, len(groups[""]), 1)
+	Equal(t, len(groups["Addresses[1]"]), 1)
+	Equal(t, len(groups["Tags"]), 1)
+
+	validate = New()
+	validate.SetOmitTopNamespace(true)
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	})
+
+	errs = validate.Struct(u)
+	NotEqual(t, errs, nil)
+
+	ve = errs.(ValidationErrors)
+	Equal(t, ve[1].Namespace(), "addresses[1].Zip")
+	Equal(t, ve[1].StructNamespace(), "Addresses[1].Zip")
+
+	// indexes added when validating many structs are kept
+	errs = validate.StructAll(User{Email: "joey@example.com"}, User{})
+	NotEqual(t, errs, nil)
+
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	Equal(t, ve[0].Namespace(), "[1].email")
+
+	// variables have no namespace to omit
+	errs = validate.Var("", "required")
+	NotEqual(t, errs, nil)
+	Equal(t, errs.(ValidationErrors)[0].Namespace(), "")
+}
+
+func TestValid(t *testing.T) {
+	type Inner struct {
+		Code string `validate:"required,len=3"`
+	}
+
+	type Outer struct {
+		Name   string `validate:"required"`
+		Age    int    `validate:"gte=18"`
+		Inner  Inner
+		Inners []Inner `validate:"dive"`
+	}
+
+	validate := New()
+
+	tests := []interface{}{
+		&Outer{Name: "joey", Age: 18, Inner: Inner{Code: "abc"}},
+		&Outer{Name: "joey", Age: 18, Inner: Inner{Code: "abc"}, Inners: []Inner{{Code: "xyz"}}},
+		&Outer{Age: 18, Inner: Inner{Code: "abc"}},
+		&Outer{Name: "joey", Age: 17, Inner: Inner{Code: "abc"}},
+		&Outer{Name: "joey", Age: 18, Inner: Inner{Code: "ab"}},
+		&Outer{Name: "joey", Age: 18, Inner: Inner{Code: "abc"}, Inners: []Inner{{Code: "xyz"}, {}}},
+		Outer{},
+	}
+
+	for i, s := range tests {
+		if validate.Valid(s) != (validate.Struct(s) == nil) {
+			t.Fatalf("Index: %d Valid disagrees with Struct", i)
+		}
+	}
+
+	Equal(t, validate.Valid(tests[0]), true)
+	Equal(t, validate.Valid(tests[2]), false)
+
+	// fail-fast doesn't leak into later validations
+	errs := validate.Struct(&Outer{})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+
+	Equal(t, validate.Valid(nil), false)
+	Equal(t, validate.Valid(1), false)
+	Equal(t, validate.Valid((*Outer)(nil)), false)
+
+	ctx := context.Background()
+	Equal(t, validate.ValidCtx(ctx, tests[1]), true)
+	Equal(t, validate.ValidCtx(ctx, tests[5]), false)
+}
+
+func TestTimeCrossFieldOrdering(t *testing.T) {
+	type Booking struct {
+		Start   time.Time
+		End     time.Time  `validate:"gtefield=Start"`
+		Cutoff  time.Time  `validate:"ltefield=Start"`
+		Resched *time.Time `validate:"omitempty,gtfield=Start"`
+		Notice  *time.Time `validate:"omitempty,ltfield=End"`
+		Same    time.Time  `validate:"eqfield=Start"`
+	}
+
+	validate := New()
+
+	start := time.Now()
+	// the same instant without the monotonic reading and in another location
+	sameStart := start.UTC()
+	later := start.Add(time.Hour)
+	earlier := start.Add(-time.Hour)
+
+	errs := validate.Struct(Booking{Start: start, End: later, Cutoff: earlier, Resched: &later, Notice: &earlier, Same: sameStart})
+	Equal(t, errs, nil)
+
+	// equal timestamps pass gtefield, ltefield and eqfield
+	errs = validate.Struct(Booking{Start: start, End: sameStart, Cutoff: sameStart, Same: sameStart})
+	Equal(t, errs, nil)
+
+	// but not gtfield and ltfield
+	errs = validate.Struct(Booking{Start: start, End: later, Cutoff: start, Resched: &sameStart, Notice: &later, Same: start})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "Booking.Resched", "Booking.Resched", "Resched", "Resched", "gtfield")
+	AssertError(t, errs, "Booking.Notice", "Booking.Notice", "Notice", "Notice", "ltfield")
+
+	errs = validate.Struct(Booking{Start: start, End: earlier, Cutoff: later, Same: later})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "Booking.End", "Booking.End", "End", "End", "gtefield")
+	AssertError(t, errs, "Booking.Cutoff", "Booking.Cutoff", "Cutoff", "Cutoff", "ltefield")
+	AssertError(t, errs, "Booking.Same", "Booking.Same", "Same", "Same", "eqfield")
+
+	errs = validate.VarWithValue(later, start, "gtefield")
+	Equal(t, errs, nil)
+
+	errs = validate.VarWithValue(sameStart, start, "gtefield")
+	Equal(t, errs, nil)
+
+	errs = validate.VarWithValue(earlier, start, "gtefield")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "gtefield")
+
+	// times never compare to other kinds
+	errs = validate.VarWithValue(start, start.Unix(), "gtefield")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "gtefield")
+}
+
+func TestFieldLevelReportErrorTag(t *testing.T) {
+	validate := New()
+
+	err := validate.RegisterValidation("username", func(fl FieldLevel) bool {
+		n := len([]rune(fl.Field().String()))
+		switch {
+		case n < 3:
+			fl.ReportErrorTag("too_short")
+			return false
+		case n > 8:
+			fl.ReportErrorTag("too_long")
+			return false
+		case strings.ContainsRune(fl.Field().String(), ' '):
+			return false
+		}
+		// reporting when passing has no effect
+		fl.ReportErrorTag("ignored")
+		return true
+	})
+	Equal(t, err, nil)
+
+	type User struct {
+		Name  string `validate:"username"`
+		Alias string `validate:"username"`
+		Nick  string `validate:"username"`
+		Other string `validate:"username,min=5"`
+	}
+
+	errs := validate.Struct(User{Name: "jo", Alias: "joeybloggs", Nick: "joe y", Other: "joey"})
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 4)
+	AssertError(t, errs, "User.Name", "User.Name", "Name", "Name", "too_short")
+	AssertError(t, errs, "User.Alias", "User.Alias", "Alias", "Alias", "too_long")
+	AssertError(t, errs, "User.Nick", "User.Nick", "Nick", "Nick", "username")
+	AssertError(t, errs, "User.Other", "User.Other", "Other", "Other", "min")
+	Equal(t, ve[0].ActualTag(), "too_short")
+
+	errs = validate.Var("joey", "username")
+	Equal(t, errs, nil)
+
+	// the reported tag is used for translations and error codes
+	validate.RegisterErrorCode("too_long", "E_TOO_LONG")
+
+	errs = validate.Var("joeybloggs", "username")
+	NotEqual(t, errs, nil)
+	Equal(t, errs.(ValidationErrors)[0].Code(), "E_TOO_LONG")
+
+	validate.RegisterAlias("handle", "username")
+
+	errs = validate.Var("jo", "handle")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "too_short")
+}
+
+func TestAlphaSpaceValidations(t *testing.T) {
+	validate := New()
+
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"alphaspace", "John Smith", true},
+		{"alphaspace", "", true},
+		{"alphaspace", " ", true},
+		{"alphaspace", "John Smith 3rd", false},
+		{"alphaspace", "John\tSmith", false},
+		{"alphaspace", "John\nSmith", false},
+		{"alphaspace", "Zoë Ångström", false},
+		{"alphanumspace", "Flat 3 Baker Street", true},
+		{"alphanumspace", "", true},
+		{"alphanumspace", "Flat 3, Baker Street", false},
+		{"alphanumspace", "Flat\t3", false},
+		{"alphaspaceunicode", "Zoë Ångström", true},
+		{"alphaspaceunicode", "", true},
+		{"alphaspaceunicode", "Zoë 3", false},
+		{"alphaspaceunicode", "Zoë\u00a0Ångström", false},
+		{"alphanumspaceunicode", "Zoë Ångström 3", true},
+		{"alphanumspaceunicode", "", true},
+		{"alphanumspaceunicode", "Zoë\nÅngström", false},
+		{"alphanumspaceunicode", "Zoë-Ångström", false},
+	}
+
+	for i, test := range tests {
+		errs := validate.Var(test.value, test.tag)
+
+		if test.valid {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d %s failed Error: %s", i, test.tag, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d %s failed Error: %s", i, test.tag, errs)
+			}
+			val := getError(errs, "", "")
+			if val.Tag() != test.tag {
+				t.Fatalf("Index: %d %s failed Error: %s", i, test.tag, errs)
+			}
+		}
+	}
+
+	errs := validate.Var("", "required,alphaspace")
+	AssertError(t, errs, "", "", "", "", "required")
+}
+
+func TestFieldLevelContextValue(t *testing.T) {
+	type tenantKey struct{}
+
+	type Order struct {
+		SKU string `validate:"sku"`
+	}
+
+	validate := New()
+	err := validate.RegisterValidation("sku", func(fl FieldLevel) bool {
+		switch fl.ContextValue(tenantKey{}) {
+		case "acme":
+			return strings.HasPrefix(fl.Field().String(), "ACME-")
+		case nil:
+			return fl.Field().Len() > 0
+		default:
+			return fl.Context().Value(tenantKey{}) != fl.Field().String()
+		}
+	})
+	Equal(t, err, nil)
+
+	ctx := validate.WithValue(context.Background(), tenantKey{}, "acme")
+
+	errs := validate.StructCtx(ctx, Order{SKU: "ACME-1"})
+	Equal(t, errs, nil)
+
+	errs = validate.StructCtx(ctx, Order{SKU: "1"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Order.SKU", "Order.SKU", "SKU", "SKU", "sku")
+
+	errs = validate.VarCtx(ctx, "1", "sku")
+	NotEqual(t, errs, nil)
+
+	errs = validate.StructCtx(validate.WithValue(nil, tenantKey{}, "globex"), Order{SKU: "globex"})
+	NotEqual(t, errs, nil)
+
+	// without a context, or without the value
+	errs = validate.Struct(Order{SKU: "1"})
+	Equal(t, errs, nil)
+
+	errs = validate.StructCtx(context.Background(), Order{})
+	NotEqual(t, errs, nil)
+
+	// the context isn't retained by the next validation
+	errs = validate.Var("1", "sku")
+	Equal(t, errs, nil)
+}
+
+func TestCheckUnique(t *testing.T) {
+	type Catalog struct {
+		Keys    []string
+		Regions []*int
+		Codes   [2]int
+		Missing *[]string
+		Name    string
+	}
+
+	var valid bool
+
+	validate := New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		valid = CheckUnique(sl, "Keys", "Regions")
+	}, Catalog{})
+
+	one, two, alsoOne := 1, 2, 1
+
+	errs := validate.Struct(Catalog{Keys: []string{"a", "a", "b"}, Regions: []*int{&one, &two, &one}})
+	Equal(t, errs, nil)
+	Equal(t, valid, true)
+
+	errs = validate.Struct(Catalog{Keys: []string{"a", "b", "a"}, Regions: []*int{&one, &two, &alsoOne}})
+	NotEqual(t, errs, nil)
+	Equal(t, valid, false)
+	AssertError(t, errs, "Catalog.Keys", "Catalog.Keys", "Keys", "Keys", "unique")
+
+	fe := getError(errs, "Catalog.Keys", "Catalog.Keys")
+	Equal(t, fe.Param(), "Keys+Regions")
+
+	// nil elements are equal
+	errs = validate.Struct(Catalog{Keys: []string{"a", "a"}, Regions: []*int{nil, nil}})
+	NotEqual(t, errs, nil)
+
+	// only the indexes present in all fields are checked
+	errs = validate.Struct(Catalog{Keys: []string{"a", "b", "a"}, Regions: []*int{&one, &two}})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Catalog{})
+	Equal(t, errs, nil)
+
+	validate = New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		valid = CheckUnique(sl, "Codes", "Missing")
+	}, Catalog{})
+
+	errs = validate.Struct(Catalog{Codes: [2]int{1, 1}})
+	Equal(t, errs, nil)
+	Equal(t, valid, true)
+
+	validate = New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		CheckUnique(sl, "Codes")
+	}, Catalog{})
+
+	errs = validate.Struct(Catalog{Codes: [2]int{1, 1}})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Catalog.Codes", "Catalog.Codes", "Codes", "Codes", "unique")
+
+	validate = New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		CheckUnique(sl, "Keys", "Nope")
+	}, Catalog{})
+	PanicMatches(t, func() { _ = validate.Struct(Catalog{}) }, "Bad field name Nope")
+
+	validate = New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		CheckUnique(sl, "Keys", "Name")
+	}, Catalog{})
+	PanicMatches(t, func() { _ = validate.Struct(Catalog{}) }, "Bad field type string for field 'Name'")
+
+	validate = New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		CheckUnique(sl)
+	}, Catalog{})
+	PanicMatches(t, func() { _ = validate.Struct(Catalog{}) }, "CheckUnique requires at least one field")
+}
+
+func TestStructChanged(t *testing.T) {
+	type Address struct {
+		Street string `validate:"required"`
+		Zip    string `validate:"numeric"`
+	}
+
+	type Item struct {
+		SKU string `validate:"required"`
+	}
+
+	type Account struct {
+		Name     string   `validate:"required"`
+		Email    string   `validate:"email"`
+		Address  Address  `validate:"required"`
+		Billing  *Address `validate:"omitempty"`
+		Items    []Item   `validate:"dive"`
+		Tags     []string `validate:"dive,alpha"`
+		Settings map[string]string
+	}
+
+	validate := New()
+
+	// stored records may predate the validations
+	original := Account{
+		Email:   "not-an-email",
+		Address: Address{Street: "1 Main St", Zip: "abc"},
+		Items:   []Item{{}},
+		Tags:    []string{"ok"},
+	}
+
+	updated := original
+	updated.Name = "Joey"
+
+	errs := validate.StructChanged(updated, original)
+	Equal(t, errs, nil)
+
+	updated.Name = ""
+	errs = validate.StructChanged(&updated, &original)
+	Equal(t, errs, nil)
+
+	updated = original
+	updated.Email = "still-not-an-email"
+	updated.Address.Street = ""
+
+	errs = validate.StructChanged(updated, original)
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Account.Email", "Account.Email", "Email", "Email", "email")
+	AssertError(t, errs, "Account.Address.Street", "Account.Address.Street", "Street", "Street", "required")
+
+	// a changed slice is validated in full
+	updated = original
+	updated.Items = []Item{{}, {SKU: "A1"}}
+	updated.Tags = []string{"ok", "n0t"}
+
+	errs = validate.StructChanged(updated, original)
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Account.Items[0].SKU", "Account.Items[0].SKU", "SKU", "SKU", "required")
+	AssertError(t, errs, "Account.Tags[1]", "Account.Tags[1]", "Tags[1]", "Tags[1]", "alpha")
+
+	// pointers set in both are compared field by field, else validated in full
+	original.Billing = &Address{Zip: "abc"}
+	updated = original
+	updated.Billing = &Address{Street: "2 Main St", Zip: "abc"}
+
+	errs = validate.StructChanged(updated, original)
+	Equal(t, errs, nil)
+
+	original.Billing = nil
+	errs = validate.StructChanged(updated, original)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Account.Billing.Zip", "Account.Billing.Zip", "Zip", "Zip", "numeric")
+
+	original.Billing = &Address{Street: "2 Main St", Zip: "abc"}
+	updated.Settings = map[string]string{"a": "b"}
+	errs = validate.StructChanged(updated, original)
+	Equal(t, errs, nil)
+
+	// struct level validations still run
+	validate = New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(sl.Current().Interface(), "Account", "Account", "invariant", "")
+	}, Account{})
+
+	errs = validate.StructChanged(original, original)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Account.Account", "Account.Account", "Account", "Account", "invariant")
+
+	errs = validate.StructChanged(original, Address{})
+	NotEqual(t, errs, nil)
+	Equal(t, IsInvalidValidationError(errs), true)
+	Equal(t, errs.Error(), "validator: original validator.Address doesn't match updated validator.Account")
+
+	errs = validate.StructChanged(original, 1)
+	NotEqual(t, errs, nil)
+	Equal(t, errs.Error(), "validator: expected a struct but got int of kind int, use Var to validate a single variable")
+
+	errs = validate.StructChanged("original", original)
+	NotEqual(t, errs, nil)
+	Equal(t, IsInvalidValidationError(errs), true)
+
+	var nilAccount *Account
+	errs = validate.StructChanged(original, nilAccount)
+	NotEqual(t, errs, nil)
+	Equal(t, IsInvalidValidationError(errs), true)
+}
+
+func TestStructChangedFlattenedAndBrackets(t *testing.T) {
+	type Audit struct {
+		Reason string `validate:"required"`
+	}
+
+	type Line struct {
+		Qty int `validate:"gt=0"`
+	}
+
+	type Order struct {
+		*Audit
+		Lines []Line `validate:"dive"`
+		Note  string `validate:"max=3"`
+	}
+
+	validate := New()
+	validate.SetFlattenEmbedded(true)
+	validate.SetNamespaceSeparator("/")
+	validate.SetNamespaceBrackets("(", ")")
+
+	original := Order{Lines: []Line{{}}, Note: "too long"}
+
+	updated := original
+	updated.Audit = &Audit{}
+	updated.Lines = []Line{{}, {Qty: 1}}
+
+	errs := validate.StructChanged(updated, original)
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Order/Reason", "Order/Reason", "Reason", "Reason", "required")
+	AssertError(t, errs, "Order/Lines(0)/Qty", "Order/Lines(0)/Qty", "Qty", "Qty", "gt")
+
+	updated.Audit = &Audit{Reason: "fix"}
+	updated.Lines = original.Lines
+	errs = validate.StructChanged(updated, original)
+	Equal(t, errs, nil)
+}
+
+func TestRegexpValidation(t *testing.T) {
+	validate := New()
+
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{`^[a-z]+$`, true},
+		{`\d{3}-\d{4}`, true},
+		{``, true},
+		{`(?i)hello`, true},
+		{`[a-z`, false},
+		{`(unclosed`, false},
+		{`a**`, false},
+		{`\p{Nope}`, false},
+	}
+
+	for i, test := range tests {
+		errs := validate.Var(test.value, "regexp")
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d regexp failed Error: %s", i, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d regexp failed Error: %s", i, errs)
+			}
+			val := getError(errs, "", "")
+			if val.Tag() != "regexp" {
+				t.Fatalf("Index: %d regexp failed Error: %s", i, errs)
+			}
+		}
+	}
+
+	type Config struct {
+		Pattern  string         `validate:"required,regexp"`
+		Compiled *regexp.Regexp `validate:"regexp"`
+		Optional *regexp.Regexp `validate:"omitempty,regexp"`
+	}
+
+	errs := validate.Struct(Config{Pattern: `^v\d+$`, Compiled: regexp.MustCompile(`x`)})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Config{Pattern: `^v(\d+$`})
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Config.Pattern", "Config.Pattern", "Pattern", "Pattern", "regexp")
+	AssertError(t, errs, "Config.Compiled", "Config.Compiled", "Compiled", "Compiled", "regexp")
+
+	Equal(t, validate.ValidateTagForType("regexp", reflect.TypeOf(&regexp.Regexp{})), nil)
+	NotEqual(t, validate.ValidateTagForType("regexp", reflect.TypeOf(1)), nil)
+
+	PanicMatches(t, func() { _ = validate.Var(1, "regexp") }, "Bad field type int")
+
+	// a *regexp.Regexp is validated as a value, not descended into, so its tags run without WithStdlibOpaqueTypes
+	validate = New()
+	err := validate.RegisterValidation("never", func(fl FieldLevel) bool { return false })
+	Equal(t, err, nil)
+
+	type Rule struct {
+		Compiled *regexp.Regexp `validate:"never"`
+	}
+
+	errs = validate.Struct(Rule{Compiled: regexp.MustCompile(`x`)})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Rule.Compiled", "Rule.Compiled", "Compiled", "Compiled", "never")
+
+	errs = validate.Var(regexp.MustCompile(`x`), "regexp")
+	Equal(t, errs, nil)
+}
+
+func TestRegexpCache(t *testing.T) {
+	pc := newPatternCache(2)
+
+	Equal(t, pc.Contains("a"), false)
+	pc.Add("a")
+	pc.Add("b")
+	Equal(t, pc.Contains("a"), true)
+
+	// b is least recently used so is evicted
+	pc.Add("c")
+	Equal(t, pc.Contains("b"), false)
+	Equal(t, pc.Contains("a"), true)
+	Equal(t, pc.Contains("c"), true)
+	Equal(t, pc.lru.Len(), 2)
+
+	validate := New()
+	Equal(t, validate.Var(`^cached-[a-z]+$`, "regexp"), nil)
+	Equal(t, regexpCache.Contains(`^cached-[a-z]+$`), true)
+
+	// invalid patterns aren't cached
+	NotEqual(t, validate.Var(`(uncached`, "regexp"), nil)
+	Equal(t, regexpCache.Contains(`(uncached`), false)
+}
+
+func TestSetEmptyStringAsNil(t *testing.T) {
+	type Profile struct {
+		Nickname *string `validate:"omitempty,min=3"`
+		Email    *string `validate:"required,email"`
+		Age      *int    `validate:"required"`
+	}
+
+	empty, short, email, zero := "", "ab", "joey@example.com", 0
+
+	validate := New()
+
+	// by default a pointer to "" has a value
+	errs := validate.Struct(Profile{Nickname: &empty, Email: &empty, Age: &zero})
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Profile.Nickname", "Profile.Nickname", "Nickname", "Nickname", "min")
+	AssertError(t, errs, "Profile.Email", "Profile.Email", "Email", "Email", "email")
+
+	validate.SetEmptyStringAsNil(true)
+
+	errs = validate.Struct(Profile{Nickname: &empty, Email: &empty, Age: &zero})
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Profile.Email", "Profile.Email", "Email", "Email", "required")
+
+	// the value isn't modified
+	p := Profile{Nickname: &empty, Email: &email, Age: &zero}
+	errs = validate.Struct(p)
+	Equal(t, errs, nil)
+	NotEqual(t, p.Nickname, nil)
+	Equal(t, *p.Nickname, "")
+
+	errs = validate.Struct(Profile{Nickname: &short, Email: &email, Age: &zero})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Profile.Nickname", "Profile.Nickname", "Nickname", "Nickname", "min")
+
+	errs = validate.Var(&empty, "required")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "required")
+
+	errs = validate.Var(&empty, "omitempty,email")
+	Equal(t, errs, nil)
+
+	// non-pointer strings are unaffected
+	errs = validate.Var(empty, "omitempty,email")
+	Equal(t, errs, nil)
+
+	validate.SetEmptyStringAsNil(false)
+
+	errs = validate.Var(&empty, "required")
+	Equal(t, errs, nil)
+}
+
+func TestDiveOnNonCollection(t *testing.T) {
+	type Config struct {
+		Retries int `validate:"dive,gt=0"`
+	}
+
+	type Nested struct {
+		Limits map[string]*int `validate:"dive,keys,alpha,endkeys,dive"`
+	}
+
+	type csv struct {
+		Raw string
+	}
+
+	type Valid struct {
+		IDs    *[]int           `validate:"omitempty,dive,gt=0"`
+		Matrix [][]int          `validate:"dive,dive,gt=0"`
+		Any    interface{}      `validate:"omitempty,dive"`
+		Labels map[string]int   `validate:"dive,keys,alpha,endkeys,gt=0"`
+		Custom csv              `validate:"dive,required"`
+		Sets   map[string][]int `validate:"dive,dive,gt=0"`
+	}
+
+	validate := New()
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		return strings.Split(field.Interface().(csv).Raw, ",")
+	}, csv{})
+
+	PanicMatches(t, func() { _ = validate.Struct(Config{}) }, "validator: dive used on non-collection type int of kind int on field 'Retries'")
+	PanicMatches(t, func() { _ = validate.Struct(Nested{}) }, "validator: dive used on non-collection type int of kind int on field 'Limits'")
+
+	errs := validate.Struct(Valid{Matrix: [][]int{{1}}, Labels: map[string]int{"a": 1}, Custom: csv{Raw: "a,b"}})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Valid{Custom: csv{Raw: "a,"}})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Valid.Custom[1]", "Valid.Custom[1]", "Custom[1]", "Custom[1]", "required")
+
+	// caught at startup
+	err := validate.ValidateTagForType("dive,gt=0", reflect.TypeOf(0))
+	NotEqual(t, err, nil)
+	tke, ok := err.(*TagKindError)
+	Equal(t, ok, true)
+	Equal(t, tke.Tag, "dive")
+	Equal(t, tke.Type == reflect.TypeOf(0), true)
+	Equal(t, err.Error(), "validator: dive used on non-collection type int of kind int")
+
+	err = validate.ValidateTagForType("dive,dive", reflect.TypeOf([]string{}))
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: dive used on non-collection type string of kind string")
+
+	Equal(t, validate.ValidateTagForType("dive,dive,gt=0", reflect.TypeOf(&[][]int{})), nil)
+
+	PanicMatches(t, func() { _ = validate.Var(1, "dive,gt=0") }, "validator: dive used on non-collection type int of kind int")
+}
+
+func TestRequiredOneOf(t *testing.T) {
+	type Contact struct {
+		Email *string `validate:"required_one_of=Email Phone,omitempty,email"`
+		Phone string
+		Tags  []string
+		Alt   string `validate:"required_one_of=Tags Nope"`
+	}
+
+	email, bad := "joey@example.com", "bad"
+
+	validate := New()
+
+	// none set
+	errs := validate.Struct(Contact{})
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Contact.Email", "Contact.Email", "Email", "Email", "required_one_of")
+	AssertError(t, errs, "Contact.Alt", "Contact.Alt", "Alt", "Alt", "required_one_of")
+
+	fe := getError(errs, "Contact.Email", "Contact.Email")
+	Equal(t, fe.Param(), "Email Phone")
+
+	// one set
+	errs = validate.Struct(Contact{Phone: "555-0100", Tags: []string{}})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Contact{Email: &email, Tags: []string{"a"}})
+	Equal(t, errs, nil)
+
+	// several set
+	errs = validate.Struct(Contact{Email: &email, Phone: "555-0100", Tags: []string{"a"}})
+	Equal(t, errs, nil)
+
+	// the remaining validations still run once satisfied
+	errs = validate.Struct(Contact{Email: &bad, Tags: []string{"a"}})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Contact.Email", "Contact.Email", "Email", "Email", "email")
+}
+
+func TestRequiredExactlyOneOf(t *testing.T) {
+	type Payment struct {
+		Card    string `validate:"required_exactly_one_of=Card IBAN Voucher"`
+		IBAN    *string
+		Voucher map[string]int
+	}
+
+	iban := "GB82WEST12345698765432"
+
+	validate := New()
+
+	// none set
+	errs := validate.Struct(Payment{})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Payment.Card", "Payment.Card", "Card", "Card", "required_exactly_one_of")
+
+	// one set
+	errs = validate.Struct(Payment{Card: "4111111111111111"})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Payment{IBAN: &iban})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Payment{Voucher: map[string]int{}})
+	Equal(t, errs, nil)
+
+	// several set
+	errs = validate.Struct(Payment{Card: "4111111111111111", IBAN: &iban})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Payment.Card", "Payment.Card", "Card", "Card", "required_exactly_one_of")
+
+	errs = validate.Struct(Payment{Card: "4111111111111111", IBAN: &iban, Voucher: map[string]int{"a": 1}})
+	NotEqual(t, errs, nil)
+}
+
+func TestDatetimeAnyValidation(t *testing.T) {
+	validate := New()
+
+	tag := "datetime_any=2006-01-02|2006-01-02T15:04:05Z07:00"
+
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{"2008-02-01", true},
+		{"2008-02-01T15:04:05Z", true},
+		{"2008-02-01T15:04:05+07:00", true},
+		{"2008-02-01T15:04:05.999Z", true},
+		{"2008-02-30", false},
+		{"2008-02-01 15:04:05", false},
+		{"01/02/2008", false},
+		{"", false},
+	}
+
+	for i, test := range tests {
+
+		errs := validate.Var(test.value, tag)
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d datetime_any failed Error: %s", i, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d datetime_any failed Error: %s", i, errs)
+			}
+			val := getError(errs, "", "")
+			if val.Tag() != "datetime_any" {
+				t.Fatalf("Index: %d datetime_any failed Error: %s", i, errs)
+			}
+			if val.Param() != "2006-01-02|2006-01-02T15:04:05Z07:00" {
+				t.Fatalf("Index: %d datetime_any failed Error: %s", i, errs)
+			}
+		}
+	}
+
+	type Event struct {
+		Start string  `validate:"required,datetime_any=2006-01-02|15:04,max=10"`
+		End   *string `validate:"omitempty,datetime_any=2006-01-02"`
+	}
+
+	end := "tomorrow"
+
+	errs := validate.Struct(Event{Start: "15:04"})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Event{Start: "2008-02-01T15:04:05Z", End: &end})
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Event.Start", "Event.Start", "Start", "Start", "datetime_any")
+	AssertError(t, errs, "Event.End", "Event.End", "End", "End", "datetime_any")
+
+	// other validations are still or'd together
+	errs = validate.Var("123", "alpha|numeric")
+	Equal(t, errs, nil)
+
+	PanicMatches(t, func() { _ = validate.Var(2, tag) }, "Bad field type int")
+}
+
+func TestStructN(t *testing.T) {
+	type Item struct {
+		SKU string `validate:"required"`
+	}
+
+	type Form struct {
+		Name   string         `validate:"required"`
+		Email  string         `validate:"required,email"`
+		Age    int            `validate:"gte=18"`
+		Items  []Item         `validate:"dive"`
+		Labels map[string]int `validate:"dive,gt=0"`
+	}
+
+	validate := New(WithSortedMapKeys())
+
+	f := Form{
+		Email:  "nope",
+		Age:    10,
+		Items:  []Item{{}, {}},
+		Labels: map[string]int{"b": 0, "a": 0},
+	}
+
+	errs := validate.Struct(f)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 7)
+
+	errs = validate.StructN(f, 3)
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	Equal(t, ve[0].Namespace(), "Form.Name")
+	Equal(t, ve[1].Namespace(), "Form.Email")
+	Equal(t, ve[2].Namespace(), "Form.Age")
+
+	errs = validate.StructN(&f, 6)
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 6)
+	Equal(t, ve[3].Namespace(), "Form.Items[0].SKU")
+	Equal(t, ve[4].Namespace(), "Form.Items[1].SKU")
+	Equal(t, ve[5].Namespace(), "Form.Labels[a]")
+
+	// stable across runs
+	for i := 0; i < 10; i++ {
+		errs = validate.StructN(f, 6)
+		Equal(t, errs.(ValidationErrors)[5].Namespace(), "Form.Labels[a]")
+	}
+
+	errs = validate.StructN(f, 1)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+
+	errs = validate.StructN(f, 0)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 7)
+
+	errs = validate.StructN(f, 100)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 7)
+
+	errs = validate.StructN(Form{Name: "Joey", Email: "joey@example.com", Age: 21}, 3)
+	Equal(t, errs, nil)
+
+	// the pooled validation doesn't keep the limit
+	errs = validate.Struct(f)
+	Equal(t, len(errs.(ValidationErrors)), 7)
+
+	// struct level validations reporting several errors are cut to n
+	validate = New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(1, "A", "A", "a", "")
+		sl.ReportError(2, "B", "B", "b", "")
+		sl.ReportError(3, "C", "C", "c", "")
+	}, Item{})
+
+	type Wrapper struct {
+		Item Item
+	}
+
+	errs = validate.StructN(Wrapper{Item: Item{SKU: "x"}}, 2)
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	Equal(t, ve[1].Namespace(), "Wrapper.Item.B")
+
+	errs = validate.StructN("f", 3)
+	NotEqual(t, errs, nil)
+	Equal(t, IsInvalidValidationError(errs), true)
+}
+
+func TestSetMaxErrors(t *testing.T) {
+	type Item struct {
+		Name string `validate:"required"`
+	}
+
+	type Test struct {
+		Items []Item   `validate:"dive"`
+		Codes []string `validate:"dive,len=2"`
+		Name  string   `validate:"required"`
+	}
+
+	tst := Test{Items: make([]Item, 100), Codes: []string{"a", "b"}}
+
+	validate := New()
+
+	errs := validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 103)
+
+	validate.SetMaxErrors(5)
+
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 6)
+	Equal(t, ve[0].Namespace(), "Test.Items[0].Name")
+	Equal(t, ve[4].Namespace(), "Test.Items[4].Name")
+	Equal(t, ve[5].Tag(), "_truncated")
+	Equal(t, ve[5].ActualTag(), "_truncated")
+	Equal(t, ve[5].Param(), "5")
+	Equal(t, ve[5].Namespace(), "")
+	Equal(t, ve[5].Field(), "")
+
+	// exactly the limit isn't truncated
+	errs = validate.Struct(Test{Items: make([]Item, 4)})
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 5)
+	Equal(t, ve[4].Namespace(), "Test.Name")
+
+	errs = validate.Var(make([]string, 10), "dive,required")
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 6)
+	Equal(t, ve[5].Tag(), "_truncated")
+
+	validate.SetMaxErrors(0)
+
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 103)
+}
+
+func TestPointerToPrimitiveValidation(t *testing.T) {
+	type Test struct {
+		Int      *int      `validate:"min=5,max=10"`
+		Float    *float64  `validate:"gt=1.5,lt=10"`
+		String   *string   `validate:"min=2,max=3"`
+		PtrPtr   **int     `validate:"gte=5"`
+		Optional *int      `validate:"omitempty,min=5"`
+		Required *string   `validate:"required,len=2"`
+		Uint     *uint8    `validate:"lte=5"`
+		Strings  []*string `validate:"dive,len=1"`
+	}
+
+	validate := New()
+
+	i, f, s, u := 7, 2.5, "abc", uint8(5)
+	ip := &i
+	one := "a"
+
+	tst := Test{Int: &i, Float: &f, String: &s, PtrPtr: &ip, Optional: &i, Required: &s, Uint: &u, Strings: []*string{&one}}
+	tst.Required = new(string)
+	*tst.Required = "ab"
+
+	errs := validate.Struct(tst)
+	Equal(t, errs, nil)
+
+	// non-nil pointers are compared by the value pointed to
+	i, f, s, u = 4, 1.5, "abcd", 6
+	two := "ab"
+	tst.Strings = []*string{&one, &two}
+
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 7)
+	AssertError(t, errs, "Test.Int", "Test.Int", "Int", "Int", "min")
+	AssertError(t, errs, "Test.Float", "Test.Float", "Float", "Float", "gt")
+	AssertError(t, errs, "Test.String", "Test.String", "String", "String", "max")
+	AssertError(t, errs, "Test.PtrPtr", "Test.PtrPtr", "PtrPtr", "PtrPtr", "gte")
+	AssertError(t, errs, "Test.Optional", "Test.Optional", "Optional", "Optional", "min")
+	AssertError(t, errs, "Test.Uint", "Test.Uint", "Uint", "Uint", "lte")
+	AssertError(t, errs, "Test.Strings[1]", "Test.Strings[1]", "Strings[1]", "Strings[1]", "len")
+	Equal(t, ve[0].Value(), 4)
+	Equal(t, ve[2].Value(), "abcd")
+
+	// nil pointers fail closed unless omitempty
+	errs = validate.Struct(Test{})
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 6)
+	AssertError(t, errs, "Test.Int", "Test.Int", "Int", "Int", "min")
+	AssertError(t, errs, "Test.Float", "Test.Float", "Float", "Float", "gt")
+	AssertError(t, errs, "Test.String", "Test.String", "String", "String", "min")
+	AssertError(t, errs, "Test.PtrPtr", "Test.PtrPtr", "PtrPtr", "PtrPtr", "gte")
+	AssertError(t, errs, "Test.Required", "Test.Required", "Required", "Required", "required")
+	AssertError(t, errs, "Test.Uint", "Test.Uint", "Uint", "Uint", "lte")
+
+	errs = validate.Var(&f, "gt=1.5")
+	NotEqual(t, errs, nil)
+
+	f = 1.75
+	errs = validate.Var(&f, "gt=1.5")
+	Equal(t, errs, nil)
+}
+
+func TestStructRules(t *testing.T) {
+	type Address struct {
+		Street string `validate:"required"`
+		Zip    string `json:"zip" validate:"omitempty,len=5"`
+	}
+
+	type Node struct {
+		Name string `validate:"required"`
+		Next *Node
+	}
+
+	type User struct {
+		Name      string              `validate:"required,min=2"`
+		Password  string              `validate:"required"`
+		Confirm   string              `validate:"eqfield=Password"`
+		Color     string              `validate:"iscolor"`
+		Contact   string              `validate:"email|e164"`
+		Address   *Address            `validate:"required"`
+		Addresses []Address           `validate:"gt=0,dive"`
+		Tags      map[string][]string `validate:"dive,keys,alpha,endkeys,dive,required"`
+		Ignored   string              `validate:"-"`
+		Untagged  string
+		Created   time.Time `validate:"required"`
+		Tree      Node
+	}
+
+	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	})
+
+	rules := validate.StructRules(&User{})
+
+	Equal(t, rules["User.Name"], []Rule{{Tag: "required"}, {Tag: "min", Param: "2"}})
+	Equal(t, rules["User.Confirm"], []Rule{{Tag: "eqfield", Param: "Password"}})
+	Equal(t, rules["User.Color"], []Rule{{Tag: "hexcolor", Or: true}, {Tag: "rgb", Or: true}, {Tag: "rgba", Or: true}, {Tag: "hsl", Or: true}, {Tag: "hsla"}})
+	Equal(t, rules["User.Contact"], []Rule{{Tag: "email", Or: true}, {Tag: "e164"}})
+	Equal(t, rules["User.Address"], []Rule{{Tag: "required"}})
+	Equal(t, rules["User.Address.Street"], []Rule{{Tag: "required"}})
+	Equal(t, rules["User.Address.zip"], []Rule{{Tag: "omitempty"}, {Tag: "len", Param: "5"}})
+	Equal(t, rules["User.Addresses"], []Rule{{Tag: "gt", Param: "0"}, {Tag: "dive"}})
+	Equal(t, rules["User.Addresses[].Street"], []Rule{{Tag: "required"}})
+	Equal(t, rules["User.Tags"], []Rule{{Tag: "dive"}, {Tag: "keys"}, {Tag: "alpha"}, {Tag: "endkeys"}, {Tag: "dive"}, {Tag: "required"}})
+	Equal(t, rules["User.Created"], []Rule{{Tag: "required"}})
+	Equal(t, rules["User.Tree.Name"], []Rule{{Tag: "required"}})
+
+	for _, ns := range []string{"User.Ignored", "User.Untagged", "User.Tree", "User.Tree.Next.Name"} {
+		_, ok := rules[ns]
+		Equal(t, ok, false)
+	}
+
+	Equal(t, len(rules), 14)
+
+	// rules match the namespaces of errors
+	errs := validate.Struct(User{Addresses: []Address{{}}})
+	NotEqual(t, errs, nil)
+	for _, fe := range errs.(ValidationErrors) {
+		ns := fe.Namespace()
+		if strings.HasPrefix(ns, "User.Addresses[0]") {
+			ns = "User.Addresses[]" + ns[len("User.Addresses[0]"):]
+		}
+		_, ok := rules[ns]
+		Equal(t, ok, true)
+	}
+
+	Equal(t, validate.StructRules(1) == nil, true)
+	Equal(t, validate.StructRules(nil) == nil, true)
+}
+
+func TestRequiredIfMultipleFields(t *testing.T) {
+	type Inner struct {
+		Region string
+	}
+
+	type Order struct {
+		Type      string
+		Region    string
+		Inner     *Inner
+		VATNumber string `validate:"required_if=Type premium Region EU"`
+		Contact   string `validate:"required_if_any=Type premium Region EU"`
+		Reference string `validate:"required_if_any=Type premium Inner.Region EU"`
+	}
+
+	validate := New()
+
+	// AND: both must match
+	errs := validate.Struct(Order{Type: "premium", Region: "EU", Contact: "x"})
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Order.VATNumber", "Order.VATNumber", "VATNumber", "VATNumber", "required_if")
+	AssertError(t, errs, "Order.Reference", "Order.Reference", "Reference", "Reference", "required_if_any")
+
+	errs = validate.Struct(Order{Type: "premium", Region: "US", Contact: "x", Reference: "y"})
+	Equal(t, errs, nil)
+
+	// OR: any may match
+	errs = validate.Struct(Order{Type: "basic", Region: "EU", VATNumber: "x"})
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Order.Contact", "Order.Contact", "Contact", "Contact", "required_if_any")
+
+	errs = validate.Struct(Order{Type: "basic", Region: "US"})
+	Equal(t, errs, nil)
+
+	// missing siblings, through a nil pointer, don't match
+	errs = validate.Struct(Order{Type: "basic"})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Order{Type: "basic", Inner: &Inner{Region: "EU"}})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Order.Reference", "Order.Reference", "Reference", "Reference", "required_if_any")
+
+	type Bad struct {
+		Field string `validate:"required_if_any=Type"`
+	}
+
+	PanicMatches(t, func() { _ = validate.Struct(Bad{}) }, "Bad param number for required_if_any Field")
+}
+
+func TestRequiredIf(t *testing.T) {
+	type Inner struct {
+		Field *string
+	}
+
+	fieldVal := "test"
+	test := struct {
+		Inner   *Inner
+		FieldE  string            `validate:"omitempty" json:"field_e"`
+		FieldER string            `validate:"required_if=FieldE test" json:"field_er"`
+		Field1  string            `validate:"omitempty" json:"field_1"`
+		Field2  *string           `validate:"required_if=Field1 test" json:"field_2"`
+		Field3  map[string]string `validate:"required_if=Field2 test" json:"field_3"`
+		Field4  interface{}       `validate:"required_if=Field3 1" json:"field_4"`
+		Field5  int               `validate:"required_if=Inner.Field test" json:"field_5"`
+		Field6  uint              `validate:"required_if=Field5 1" json:"field_6"`
+		Field7  float32           `validate:"required_if=Field6 1" json:"field_7"`
+		Field8  float64           `validate:"required_if=Field7 1.0" json:"field_8"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: 2,
+	}
+
+	validate := New()
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test2 := struct {
+		Inner   *Inner
+		Inner2  *Inner
+		FieldE  string            `validate:"omitempty" json:"field_e"`
+		FieldER string            `validate:"required_if=FieldE test" json:"field_er"`
+		Field1  string            `validate:"omitempty" json:"field_1"`
+		Field2  *string           `validate:"required_if=Field1 test" json:"field_2"`
+		Field3  map[string]string `validate:"required_if=Field2 test" json:"field_3"`
+		Field4  interface{}       `validate:"required_if=Field2 test" json:"field_4"`
+		Field5  string            `validate:"required_if=Field3 1" json:"field_5"`
+		Field6  string            `validate:"required_if=Inner.Field test" json:"field_6"`
+		Field7  string            `validate:"required_if=Inner2.Field test" json:"field_7"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field2: &fieldVal,
+	}
+
+	errs = validate.Struct(test2)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	AssertError(t, errs, "Field3", "Field3", "Field3", "Field3", "required_if")
+	AssertError(t, errs, "Field4", "Field4", "Field4", "Field4", "required_if")
+	AssertError(t, errs, "Field6", "Field6", "Field6", "Field6", "required_if")
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("test3 should have panicked!")
+		}
+	}()
+
+	test3 := struct {
+		Inner  *Inner
+		Field1 string `validate:"required_if=Inner.Field" json:"field_1"`
+	}{
+		Inner: &Inner{Field: &fieldVal},
+	}
+	_ = validate.Struct(test3)
+}
+
+func TestRequiredUnless(t *testing.T) {
+	type Inner struct {
+		Field *string
+	}
+
+	fieldVal := "test"
+	test := struct {
+		Inner   *Inner
+		FieldE  string            `validate:"omitempty" json:"field_e"`
+		FieldER string            `validate:"required_unless=FieldE test" json:"field_er"`
+		Field1  string            `validate:"omitempty" json:"field_1"`
+		Field2  *string           `validate:"required_unless=Field1 test" json:"field_2"`
+		Field3  map[string]string `validate:"required_unless=Field2 test" json:"field_3"`
+		Field4  interface{}       `validate:"required_unless=Field3 1" json:"field_4"`
+		Field5  int               `validate:"required_unless=Inner.Field test" json:"field_5"`
+		Field6  uint              `validate:"required_unless=Field5 2" json:"field_6"`
+		Field7  float32           `validate:"required_unless=Field6 0" json:"field_7"`
+		Field8  float64           `validate:"required_unless=Field7 0.0" json:"field_8"`
+		Field9  bool              `validate:"omitempty" json:"field_9"`
+		Field10 string            `validate:"required_unless=Field9 true" json:"field_10"`
+	}{
+		FieldE: "test",
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: 2,
+		Field9: true,
+	}
+
+	validate := New()
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test2 := struct {
+		Inner   *Inner
+		Inner2  *Inner
+		FieldE  string            `validate:"omitempty" json:"field_e"`
+		FieldER string            `validate:"required_unless=FieldE test" json:"field_er"`
+		Field1  string            `validate:"omitempty" json:"field_1"`
+		Field2  *string           `validate:"required_unless=Field1 test" json:"field_2"`
+		Field3  map[string]string `validate:"required_unless=Field2 test" json:"field_3"`
+		Field4  interface{}       `validate:"required_unless=Field2 test" json:"field_4"`
+		Field5  string            `validate:"required_unless=Field3 0" json:"field_5"`
+		Field6  string            `validate:"required_unless=Inner.Field test" json:"field_6"`
+		Field7  string            `validate:"required_unless=Inner2.Field test" json:"field_7"`
+		Field8  bool              `validate:"omitempty" json:"field_8"`
+		Field9  string            `validate:"required_unless=Field8 true" json:"field_9"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		FieldE: "test",
+		Field1: "test",
+	}
+
+	errs = validate.Struct(test2)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 4)
+	AssertError(t, errs, "Field3", "Field3", "Field3", "Field3", "required_unless")
+	AssertError(t, errs, "Field4", "Field4", "Field4", "Field4", "required_unless")
+	AssertError(t, errs, "Field7", "Field7", "Field7", "Field7", "required_unless")
+	AssertError(t, errs, "Field9", "Field9", "Field9", "Field9", "required_unless")
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("test3 should have panicked!")
+		}
+	}()
+
+	test3 := struct {
+		Inner  *Inner
+		Field1 string `validate:"required_unless=Inner.Field" json:"field_1"`
+	}{
+		Inner: &Inner{Field: &fieldVal},
+	}
+	_ = validate.Struct(test3)
+}
+
+func TestRequiredWith(t *testing.T) {
+	type Inner struct {
+		Field *string
+	}
+
+	fieldVal := "test"
+	test := struct {
+		Inner   *Inner
+		FieldE  string            `validate:"omitempty" json:"field_e"`
+		FieldER string            `validate:"required_with=FieldE" json:"field_er"`
+		Field1  string            `validate:"omitempty" json:"field_1"`
+		Field2  *string           `validate:"required_with=Field1" json:"field_2"`
+		Field3  map[string]string `validate:"required_with=Field2" json:"field_3"`
+		Field4  interface{}       `validate:"required_with=Field3" json:"field_4"`
+		Field5  string            `validate:"required_with=Inner.Field" json:"field_5"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+	}
+
+	validate := New()
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test2 := struct {
+		Inner   *Inner
+		Inner2  *Inner
+		FieldE  string            `validate:"omitempty" json:"field_e"`
+		FieldER string            `validate:"required_with=FieldE" json:"field_er"`
+		Field1  string            `validate:"omitempty" json:"field_1"`
+		Field2  *string           `validate:"required_with=Field1" json:"field_2"`
+		Field3  map[string]string `validate:"required_with=Field2" json:"field_3"`
+		Field4  interface{}       `validate:"required_with=Field2" json:"field_4"`
+		Field5  string            `validate:"required_with=Field3" json:"field_5"`
+		Field6  string            `validate:"required_with=Inner.Field" json:"field_6"`
+		Field7  string            `validate:"required_with=Inner2.Field" json:"field_7"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field2: &fieldVal,
+	}
+
+	errs = validate.Struct(test2)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	AssertError(t, errs, "Field3", "Field3", "Field3", "Field3", "required_with")
+	AssertError(t, errs, "Field4", "Field4", "Field4", "Field4", "required_with")
+	AssertError(t, errs, "Field6", "Field6", "Field6", "Field6", "required_with")
+}
+
+func TestExcludedWith(t *testing.T) {
+	type Inner struct {
+		FieldE string
+		Field  *string
+	}
+
+	fieldVal := "test"
+	test := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_with=FieldE" json:"field_1"`
+		Field2 *string           `validate:"excluded_with=FieldE" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_with=FieldE" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_with=FieldE" json:"field_4"`
+		Field5 string            `validate:"excluded_with=Inner.FieldE" json:"field_5"`
+		Field6 string            `validate:"excluded_with=Inner2.FieldE" json:"field_6"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field1: fieldVal,
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+		Field6: "test",
+	}
+
+	validate := New()
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test2 := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_with=Field" json:"field_1"`
+		Field2 *string           `validate:"excluded_with=Field" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_with=Field" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_with=Field" json:"field_4"`
+		Field5 string            `validate:"excluded_with=Inner.Field" json:"field_5"`
+		Field6 string            `validate:"excluded_with=Inner2.Field" json:"field_6"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field:  "populated",
+		Field1: fieldVal,
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+		Field6: "test",
+	}
+
+	errs = validate.Struct(test2)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 5)
+	for i := 1; i <= 5; i++ {
+		name := fmt.Sprintf("Field%d", i)
+		AssertError(t, errs, name, name, name, name, "excluded_with")
+	}
+
+	test3 := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_with=FieldE" json:"field_1"`
+		Field2 *string           `validate:"excluded_with=FieldE" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_with=FieldE" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_with=FieldE" json:"field_4"`
+		Field5 string            `validate:"excluded_with=Inner.FieldE" json:"field_5"`
+		Field6 string            `validate:"excluded_with=Inner2.FieldE" json:"field_6"`
+	}{
+		Inner:  &Inner{FieldE: "populated"},
+		Inner2: &Inner{FieldE: "populated"},
+		FieldE: "populated",
+	}
+
+	validate = New()
+
+	errs = validate.Struct(test3)
+	Equal(t, errs, nil)
+}
+
+func TestExcludedWithout(t *testing.T) {
+	type Inner struct {
+		FieldE string
+		Field  *string
+	}
+
+	fieldVal := "test"
+	test := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_without=Field" json:"field_1"`
+		Field2 *string           `validate:"excluded_without=Field" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_without=Field" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_without=Field" json:"field_4"`
+		Field5 string            `validate:"excluded_without=Inner.Field" json:"field_5"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field:  "populated",
+		Field1: fieldVal,
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+	}
+
+	validate := New()
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test2 := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_without=FieldE" json:"field_1"`
+		Field2 *string           `validate:"excluded_without=FieldE" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_without=FieldE" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_without=FieldE" json:"field_4"`
+		Field5 string            `validate:"excluded_without=Inner.FieldE" json:"field_5"`
+		Field6 string            `validate:"excluded_without=Inner2.FieldE" json:"field_6"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field1: fieldVal,
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+		Field6: "test",
+	}
+
+	errs = validate.Struct(test2)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 6)
+	for i := 1; i <= 6; i++ {
+		name := fmt.Sprintf("Field%d", i)
+		AssertError(t, errs, name, name, name, name, "excluded_without")
+	}
+
+	test3 := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_without=Field" json:"field_1"`
+		Field2 *string           `validate:"excluded_without=Field" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_without=Field" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_without=Field" json:"field_4"`
+		Field5 string            `validate:"excluded_without=Inner.Field" json:"field_5"`
+	}{
+		Inner: &Inner{Field: &fieldVal},
+		Field: "populated",
+	}
+
+	validate = New()
+
+	errs = validate.Struct(test3)
+	Equal(t, errs, nil)
+}
+
+func TestExcludedWithAll(t *testing.T) {
+	type Inner struct {
+		FieldE string
+		Field  *string
+	}
+
+	fieldVal := "test"
+	test := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_with_all=FieldE Field" json:"field_1"`
+		Field2 *string           `validate:"excluded_with_all=FieldE Field" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_with_all=FieldE Field" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_with_all=FieldE Field" json:"field_4"`
+		Field5 string            `validate:"excluded_with_all=Inner.FieldE" json:"field_5"`
+		Field6 string            `validate:"excluded_with_all=Inner2.FieldE" json:"field_6"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field:  fieldVal,
+		Field1: fieldVal,
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+		Field6: "test",
+	}
+
+	validate := New()
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test2 := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_with_all=Field FieldE" json:"field_1"`
+		Field2 *string           `validate:"excluded_with_all=Field FieldE" json:"field_2"`
 		Field3 map[string]string `validate:"excluded_with_all=Field FieldE" json:"field_3"`
 		Field4 interface{}       `validate:"excluded_with_all=Field FieldE" json:"field_4"`
 		Field5 string            `validate:"excluded_with_all=Inner.Field" json:"field_5"`
 		Field6 string            `validate:"excluded_with_all=Inner2.Field" json:"field_6"`
 	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field:  "populated",
-		FieldE: "populated",
-		Field1: fieldVal,
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
-		Field6: "test",
+		Inner:  &Inner{Field: &fieldVal},
+		Field:  "populated",
+		FieldE: "populated",
+		Field1: fieldVal,
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+		Field6: "test",
+	}
+
+	errs = validate.Struct(test2)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 5)
+	for i := 1; i <= 5; i++ {
+		name := fmt.Sprintf("Field%d", i)
+		AssertError(t, errs, name, name, name, name, "excluded_with_all")
+	}
+
+	test3 := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_with_all=FieldE Field" json:"field_1"`
+		Field2 *string           `validate:"excluded_with_all=FieldE Field" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_with_all=FieldE Field" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_with_all=FieldE Field" json:"field_4"`
+		Field5 string            `validate:"excluded_with_all=Inner.FieldE" json:"field_5"`
+		Field6 string            `validate:"excluded_with_all=Inner2.FieldE" json:"field_6"`
+	}{
+		Inner:  &Inner{FieldE: "populated"},
+		Inner2: &Inner{FieldE: "populated"},
+		Field:  "populated",
+		FieldE: "populated",
+	}
+
+	validate = New()
+
+	errs = validate.Struct(test3)
+	Equal(t, errs, nil)
+}
+
+func TestExcludedWithoutAll(t *testing.T) {
+	type Inner struct {
+		FieldE string
+		Field  *string
+	}
+
+	fieldVal := "test"
+	test := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_without_all=Field FieldE" json:"field_1"`
+		Field2 *string           `validate:"excluded_without_all=Field FieldE" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_without_all=Field FieldE" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_without_all=Field FieldE" json:"field_4"`
+		Field5 string            `validate:"excluded_without_all=Inner.Field Inner2.Field" json:"field_5"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Inner2: &Inner{Field: &fieldVal},
+		Field:  "populated",
+		Field1: fieldVal,
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+	}
+
+	validate := New()
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test2 := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_without_all=FieldE Field" json:"field_1"`
+		Field2 *string           `validate:"excluded_without_all=FieldE Field" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_without_all=FieldE Field" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_without_all=FieldE Field" json:"field_4"`
+		Field5 string            `validate:"excluded_without_all=Inner.FieldE" json:"field_5"`
+		Field6 string            `validate:"excluded_without_all=Inner2.FieldE" json:"field_6"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field1: fieldVal,
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+		Field6: "test",
+	}
+
+	errs = validate.Struct(test2)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 6)
+	for i := 1; i <= 6; i++ {
+		name := fmt.Sprintf("Field%d", i)
+		AssertError(t, errs, name, name, name, name, "excluded_without_all")
+	}
+
+	test3 := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field  string            `validate:"omitempty" json:"field"`
+		FieldE string            `validate:"omitempty" json:"field_e"`
+		Field1 string            `validate:"excluded_without_all=Field FieldE" json:"field_1"`
+		Field2 *string           `validate:"excluded_without_all=Field FieldE" json:"field_2"`
+		Field3 map[string]string `validate:"excluded_without_all=Field FieldE" json:"field_3"`
+		Field4 interface{}       `validate:"excluded_without_all=Field FieldE" json:"field_4"`
+		Field5 string            `validate:"excluded_without_all=Inner.Field Inner2.Field" json:"field_5"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Inner2: &Inner{Field: &fieldVal},
+		Field:  "populated",
+		FieldE: "populated",
+	}
+
+	validate = New()
+
+	errs = validate.Struct(test3)
+	Equal(t, errs, nil)
+}
+
+func TestRequiredWithAll(t *testing.T) {
+	type Inner struct {
+		Field *string
+	}
+
+	fieldVal := "test"
+	test := struct {
+		Inner   *Inner
+		FieldE  string            `validate:"omitempty" json:"field_e"`
+		FieldER string            `validate:"required_with_all=FieldE" json:"field_er"`
+		Field1  string            `validate:"omitempty" json:"field_1"`
+		Field2  *string           `validate:"required_with_all=Field1" json:"field_2"`
+		Field3  map[string]string `validate:"required_with_all=Field2" json:"field_3"`
+		Field4  interface{}       `validate:"required_with_all=Field3" json:"field_4"`
+		Field5  string            `validate:"required_with_all=Inner.Field" json:"field_5"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field1: "test_field1",
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+	}
+
+	validate := New()
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test2 := struct {
+		Inner   *Inner
+		Inner2  *Inner
+		FieldE  string            `validate:"omitempty" json:"field_e"`
+		FieldER string            `validate:"required_with_all=FieldE" json:"field_er"`
+		Field1  string            `validate:"omitempty" json:"field_1"`
+		Field2  *string           `validate:"required_with_all=Field1" json:"field_2"`
+		Field3  map[string]string `validate:"required_with_all=Field2" json:"field_3"`
+		Field4  interface{}       `validate:"required_with_all=Field1 FieldE" json:"field_4"`
+		Field5  string            `validate:"required_with_all=Inner.Field Field2" json:"field_5"`
+		Field6  string            `validate:"required_with_all=Inner2.Field Field2" json:"field_6"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field2: &fieldVal,
+	}
+
+	errs = validate.Struct(test2)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Field3", "Field3", "Field3", "Field3", "required_with_all")
+	AssertError(t, errs, "Field5", "Field5", "Field5", "Field5", "required_with_all")
+}
+
+func TestRequiredWithout(t *testing.T) {
+	type Inner struct {
+		Field *string
+	}
+
+	fieldVal := "test"
+	test := struct {
+		Inner  *Inner
+		Field1 string            `validate:"omitempty" json:"field_1"`
+		Field2 *string           `validate:"required_without=Field1" json:"field_2"`
+		Field3 map[string]string `validate:"required_without=Field2" json:"field_3"`
+		Field4 interface{}       `validate:"required_without=Field3" json:"field_4"`
+		Field5 string            `validate:"required_without=Field3" json:"field_5"`
+	}{
+		Inner:  &Inner{Field: &fieldVal},
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+	}
+
+	validate := New()
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test2 := struct {
+		Inner  *Inner
+		Inner2 *Inner
+		Field1 string            `json:"field_1"`
+		Field2 *string           `validate:"required_without=Field1" json:"field_2"`
+		Field3 map[string]string `validate:"required_without=Field2" json:"field_3"`
+		Field4 interface{}       `validate:"required_without=Field3" json:"field_4"`
+		Field5 string            `validate:"required_without=Field3" json:"field_5"`
+		Field6 string            `validate:"required_without=Field1" json:"field_6"`
+		Field7 string            `validate:"required_without=Inner.Field" json:"field_7"`
+		Field8 string            `validate:"required_without=Inner.Field" json:"field_8"`
+	}{
+		Inner:  &Inner{},
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+	}
+
+	errs = validate.Struct(&test2)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 4)
+	AssertError(t, errs, "Field2", "Field2", "Field2", "Field2", "required_without")
+	AssertError(t, errs, "Field6", "Field6", "Field6", "Field6", "required_without")
+	AssertError(t, errs, "Field7", "Field7", "Field7", "Field7", "required_without")
+	AssertError(t, errs, "Field8", "Field8", "Field8", "Field8", "required_without")
+
+	test3 := struct {
+		Field1 *string `validate:"required_without=Field2,omitempty,min=1" json:"field_1"`
+		Field2 *string `validate:"required_without=Field1,omitempty,min=1" json:"field_2"`
+	}{
+		Field1: &fieldVal,
+	}
+
+	errs = validate.Struct(&test3)
+	Equal(t, errs, nil)
+}
+
+func TestRequiredWithoutAll(t *testing.T) {
+	fieldVal := "test"
+	test := struct {
+		Field1 string            `validate:"omitempty" json:"field_1"`
+		Field2 *string           `validate:"required_without_all=Field1" json:"field_2"`
+		Field3 map[string]string `validate:"required_without_all=Field2" json:"field_3"`
+		Field4 interface{}       `validate:"required_without_all=Field3" json:"field_4"`
+		Field5 string            `validate:"required_without_all=Field3" json:"field_5"`
+	}{
+		Field1: "",
+		Field2: &fieldVal,
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+	}
+
+	validate := New()
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test2 := struct {
+		Field1 string            `validate:"omitempty" json:"field_1"`
+		Field2 *string           `validate:"required_without_all=Field1" json:"field_2"`
+		Field3 map[string]string `validate:"required_without_all=Field2" json:"field_3"`
+		Field4 interface{}       `validate:"required_without_all=Field3" json:"field_4"`
+		Field5 string            `validate:"required_without_all=Field3" json:"field_5"`
+		Field6 string            `validate:"required_without_all=Field1 Field3" json:"field_6"`
+	}{
+		Field3: map[string]string{"key": "val"},
+		Field4: "test",
+		Field5: "test",
+	}
+
+	errs = validate.Struct(test2)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Field2", "Field2", "Field2", "Field2", "required_without_all")
+}
+
+func TestLookup(t *testing.T) {
+	type Lookup struct {
+		FieldA *string `json:"fieldA,omitempty" validate:"required_without=FieldB"`
+		FieldB *string `json:"fieldB,omitempty" validate:"required_without=FieldA"`
+	}
+
+	fieldAValue := "1232"
+	lookup := Lookup{
+		FieldA: &fieldAValue,
+		FieldB: nil,
+	}
+	Equal(t, New().Struct(lookup), nil)
+}
+
+func TestAbilityToValidateNils(t *testing.T) {
+	type TestStruct struct {
+		Test *string `validate:"nil"`
+	}
+
+	ts := TestStruct{}
+	val := New()
+	fn := func(fl FieldLevel) bool {
+		return fl.Field().Kind() == reflect.Ptr && fl.Field().IsNil()
+	}
+
+	err := val.RegisterValidation("nil", fn, true)
+	Equal(t, err, nil)
+
+	errs := val.Struct(ts)
+	Equal(t, errs, nil)
+
+	str := "string"
+	ts.Test = &str
+
+	errs = val.Struct(ts)
+	NotEqual(t, errs, nil)
+}
+
+func TestRequiredWithoutPointers(t *testing.T) {
+	type Lookup struct {
+		FieldA *bool `json:"fieldA,omitempty" validate:"required_without=FieldB"`
+		FieldB *bool `json:"fieldB,omitempty" validate:"required_without=FieldA"`
+	}
+
+	b := true
+	lookup := Lookup{
+		FieldA: &b,
+		FieldB: nil,
+	}
+
+	val := New()
+	errs := val.Struct(lookup)
+	Equal(t, errs, nil)
+
+	b = false
+	lookup = Lookup{
+		FieldA: &b,
+		FieldB: nil,
+	}
+	errs = val.Struct(lookup)
+	Equal(t, errs, nil)
+}
+
+func TestRequiredWithoutAllPointers(t *testing.T) {
+	type Lookup struct {
+		FieldA *bool `json:"fieldA,omitempty" validate:"required_without_all=FieldB"`
+		FieldB *bool `json:"fieldB,omitempty" validate:"required_without_all=FieldA"`
+	}
+
+	b := true
+	lookup := Lookup{
+		FieldA: &b,
+		FieldB: nil,
+	}
+
+	val := New()
+	errs := val.Struct(lookup)
+	Equal(t, errs, nil)
+
+	b = false
+	lookup = Lookup{
+		FieldA: &b,
+		FieldB: nil,
+	}
+	errs = val.Struct(lookup)
+	Equal(t, errs, nil)
+}
+
+func TestGetTag(t *testing.T) {
+	var tag string
+
+	type Test struct {
+		String string `validate:"mytag"`
+	}
+
+	val := New()
+	_ = val.RegisterValidation("mytag", func(fl FieldLevel) bool {
+		tag = fl.GetTag()
+		return true
+	})
+
+	var test Test
+	errs := val.Struct(test)
+	Equal(t, errs, nil)
+	Equal(t, tag, "mytag")
+}
+
+func TestGetTagSharedFunc(t *testing.T) {
+	var tags []string
+
+	fn := func(fl FieldLevel) bool {
+		tags = append(tags, fl.GetTag())
+
+		switch fl.GetTag() {
+		case "even":
+			return fl.Field().Int()%2 == 0
+		case "odd":
+			return fl.Field().Int()%2 == 1
+		}
+		return false
+	}
+
+	type Test struct {
+		Even int `validate:"even"`
+		Odd  int `validate:"odd"`
+		Pair int `validate:"pair"`
+	}
+
+	val := New()
+	err := val.RegisterValidation("even", fn)
+	Equal(t, err, nil)
+	err = val.RegisterValidation("odd", fn)
+	Equal(t, err, nil)
+	val.RegisterAlias("pair", "even")
+
+	errs := val.Struct(Test{Even: 2, Odd: 3, Pair: 4})
+	Equal(t, errs, nil)
+	Equal(t, tags, []string{"even", "odd", "even"})
+
+	tags = nil
+	errs = val.Struct(Test{Even: 1, Odd: 2, Pair: 5})
+	NotEqual(t, errs, nil)
+	Equal(t, tags, []string{"even", "odd", "even"})
+	AssertError(t, errs, "Test.Even", "Test.Even", "Even", "Even", "even")
+	AssertError(t, errs, "Test.Odd", "Test.Odd", "Odd", "Odd", "odd")
+	AssertError(t, errs, "Test.Pair", "Test.Pair", "Pair", "Pair", "pair")
+
+	fe := getError(errs, "Test.Pair", "Test.Pair")
+	Equal(t, fe.ActualTag(), "even")
+}
+
+func TestRegisterValidationMap(t *testing.T) {
+	type Test struct {
+		A string `validate:"tag_a"`
+		B string `validate:"tag_b"`
+	}
+
+	val := New()
+	err := val.RegisterValidationMap(map[string]Func{
+		"tag_a": func(fl FieldLevel) bool { return fl.Field().String() == "a" },
+		"tag_b": func(fl FieldLevel) bool { return fl.Field().String() == "b" },
+	})
+	Equal(t, err, nil)
+
+	errs := val.Struct(Test{A: "a", B: "b"})
+	Equal(t, errs, nil)
+
+	errs = val.Struct(Test{A: "b", B: "a"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "Test.A", "Test.A", "A", "A", "tag_a")
+	AssertError(t, errs, "Test.B", "Test.B", "B", "B", "tag_b")
+
+	err = val.RegisterValidationMap(map[string]Func{
+		"tag_c": func(fl FieldLevel) bool { return true },
+		"tag_d": nil,
+	})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "Function cannot be empty")
+}
+
+func TestStructValueAndVarValue(t *testing.T) {
+	type Test struct {
+		Name string `validate:"required"`
+	}
+
+	validate := New()
+
+	errs := validate.StructValue(reflect.ValueOf(Test{Name: "joeybloggs"}))
+	Equal(t, errs, nil)
+
+	errs = validate.StructValue(reflect.ValueOf(&Test{}))
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Name", "Test.Name", "Name", "Name", "required")
+
+	errs = validate.StructValueCtx(context.Background(), reflect.ValueOf(Test{}))
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Name", "Test.Name", "Name", "Name", "required")
+
+	errs = validate.StructValue(reflect.ValueOf("test"))
+	NotEqual(t, errs, nil)
+	Equal(t, errs.Error(), "validator: expected a struct but got string of kind string, use Var to validate a single variable")
+
+	errs = validate.StructValue(reflect.Value{})
+	NotEqual(t, errs, nil)
+	Equal(t, errs.Error(), "validator: (nil)")
+
+	errs = validate.VarValue(reflect.ValueOf("test"), "required,len=4")
+	Equal(t, errs, nil)
+
+	errs = validate.VarValue(reflect.ValueOf(""), "required")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "required")
+
+	errs = validate.VarValueCtx(context.Background(), reflect.ValueOf(5), "min=10")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "min")
+}
+
+func TestNotBlankValidation(t *testing.T) {
+	validate := New()
+
+	tests := []struct {
+		value    interface{}
+		tag      string
+		expected bool
+	}{
+		{"test", "notblank", true},
+		{" test ", "notblank", true},
+		{"", "notblank", false},
+		{" ", "notblank", false},
+		{"\t\n ", "notblank", false},
+		{"", "omitempty,notblank", true},
+		{" ", "omitempty,notblank", false},
+		{[]int{1}, "notblank", true},
+		{[]int{}, "notblank", false},
+		{map[string]int{"a": 1}, "notblank", true},
+		{map[string]int{}, "notblank", false},
+		{1, "notblank", true},
+		{0, "notblank", false},
+	}
+
+	for i, test := range tests {
+		errs := validate.Var(test.value, test.tag)
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d notblank failed Error: %s", i, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d notblank failed Error: %s", i, errs)
+			} else {
+				val := getError(errs, "", "")
+				if val.Tag() != "notblank" {
+					t.Fatalf("Index: %d notblank failed Error: %s", i, errs)
+				}
+			}
+		}
+	}
+
+	type Test struct {
+		Name    string  `validate:"notblank"`
+		Default string  `validate:"isdefault"`
+		Ptr     *string `validate:"notblank"`
+	}
+
+	blank := "  "
+	tt := Test{Name: " ", Default: "x", Ptr: &blank}
+
+	errs := validate.Struct(tt)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "Test.Name", "Test.Name", "Name", "Name", "notblank")
+	AssertError(t, errs, "Test.Default", "Test.Default", "Default", "Default", "isdefault")
+	AssertError(t, errs, "Test.Ptr", "Test.Ptr", "Ptr", "Ptr", "notblank")
+}
+
+func TestStructMultiplePointerValidation(t *testing.T) {
+	type User struct {
+		Name string `validate:"required"`
+	}
+
+	validate := New()
+
+	u := &User{Name: "joeybloggs"}
+	errs := validate.Struct(&u)
+	Equal(t, errs, nil)
+
+	u.Name = ""
+	errs = validate.Struct(&u)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "User.Name", "User.Name", "Name", "Name", "required")
+
+	uu := &u
+	errs = validate.StructPartial(&uu, "Name")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "User.Name", "User.Name", "Name", "Name", "required")
+
+	errs = validate.StructExcept(&u, "Name")
+	Equal(t, errs, nil)
+
+	errs = validate.StructFiltered(&u, func(ns []byte) bool { return false })
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "User.Name", "User.Name", "Name", "Name", "required")
+
+	var nilUser *User
+	errs = validate.Struct(&nilUser)
+	NotEqual(t, errs, nil)
+	Equal(t, errs.Error(), "validator: (nil **validator.User)")
+
+	anon := &struct {
+		Name string `validate:"required"`
+	}{}
+	errs = validate.Struct(anon)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Name", "Name", "Name", "Name", "required")
+
+	errs = validate.Struct(&anon)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Name", "Name", "Name", "Name", "required")
+
+	anon.Name = "joeybloggs"
+	errs = validate.Struct(&anon)
+	Equal(t, errs, nil)
+}
+
+func TestExcludedFamilyCombinations(t *testing.T) {
+	type Login struct {
+		Password string
+		Session  *string
+		Token    string `validate:"excluded_with=Password"`
+		Key      string `validate:"excluded_with_all=Password Session"`
+		Anon     string `validate:"excluded_without=Password"`
+		Guest    string `validate:"excluded_without_all=Password Session"`
+	}
+
+	validate := New()
+	session := "abc"
+
+	tests := []struct {
+		login    Login
+		failures []string
+	}{
+		{Login{}, nil},
+		{Login{Guest: "g", Anon: "a"}, []string{"Anon", "Guest"}},
+		{Login{Token: "t"}, nil},
+		{Login{Password: "p", Token: "t"}, []string{"Token"}},
+		{Login{Password: "p", Key: "k"}, nil},
+		{Login{Password: "p", Session: &session, Key: "k"}, []string{"Key"}},
+		{Login{Password: "p", Anon: "a"}, nil},
+		{Login{Session: &session, Anon: "a", Guest: "g"}, []string{"Anon"}},
+		{Login{Password: "p", Session: &session, Token: "t", Key: "k", Anon: "a", Guest: "g"}, []string{"Token", "Key"}},
+	}
+
+	for i, test := range tests {
+		errs := validate.Struct(test.login)
+
+		if len(test.failures) == 0 {
+			if errs != nil {
+				t.Fatalf("Index: %d unexpected errors: %s", i, errs)
+			}
+			continue
+		}
+
+		NotEqual(t, errs, nil)
+		ve := errs.(ValidationErrors)
+		Equal(t, len(ve), len(test.failures))
+
+		for j, fld := range test.failures {
+			Equal(t, ve[j].Field(), fld)
+			sf, _ := reflect.TypeOf(test.login).FieldByName(fld)
+			Equal(t, ve[j].Tag()+"="+ve[j].Param(), sf.Tag.Get("validate"))
+		}
+	}
+}
+
+func TestFieldLevelParams(t *testing.T) {
+	validate := New()
+
+	var params []string
+	var raw string
+
+	err := validate.RegisterValidation("between", func(fl FieldLevel) bool {
+		raw = fl.Param()
+		params = fl.Params()
+
+		min, _ := strconv.Atoi(params[0])
+		max, _ := strconv.Atoi(params[1])
+		i := int(fl.Field().Int())
+
+		return i >= min && i <= max
+	})
+	Equal(t, err, nil)
+
+	errs := validate.Var(5, "between=3 10")
+	Equal(t, errs, nil)
+	Equal(t, raw, "3 10")
+	Equal(t, params, []string{"3", "10"})
+
+	errs = validate.Var(11, "between=3 10")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "between")
+
+	err = validate.RegisterValidation("params", func(fl FieldLevel) bool {
+		params = fl.Params()
+		return true
+	})
+	Equal(t, err, nil)
+
+	errs = validate.Var("", "params='a b' c 0x2C")
+	Equal(t, errs, nil)
+	Equal(t, params, []string{"a b", "c", ","})
+
+	// modifying the returned params must not affect subsequent calls
+	params[0] = "changed"
+	errs = validate.Var("", "params='a b' c 0x2C")
+	Equal(t, errs, nil)
+	Equal(t, params, []string{"a b", "c", ","})
+
+	errs = validate.Var("", "params")
+	Equal(t, errs, nil)
+	Equal(t, len(params), 0)
+}
+
+func TestDiveCrossFieldValidation(t *testing.T) {
+	type Test struct {
+		Cap   int
+		Vals  []int          `validate:"dive,ltefield=Cap"`
+		Map   map[string]int `validate:"dive,ltfield=Cap"`
+		Outer [][]int        `validate:"dive,dive,ltefield=Cap"`
+	}
+
+	validate := New()
+
+	test := Test{
+		Cap:   3,
+		Vals:  []int{1, 2, 3},
+		Map:   map[string]int{"a": 2},
+		Outer: [][]int{{1}, {3}},
+	}
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test.Vals = []int{1, 4, 3}
+	test.Map = map[string]int{"a": 3}
+	test.Outer = [][]int{{1}, {2, 5}}
+
+	errs = validate.Struct(test)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "Test.Vals[1]", "Test.Vals[1]", "Vals[1]", "Vals[1]", "ltefield")
+	AssertError(t, errs, "Test.Map[a]", "Test.Map[a]", "Map[a]", "Map[a]", "ltfield")
+	AssertError(t, errs, "Test.Outer[1][1]", "Test.Outer[1][1]", "Outer[1][1]", "Outer[1][1]", "ltefield")
+}
+
+func TestRegisterErrorCode(t *testing.T) {
+	type Test struct {
+		Name  string `validate:"required"`
+		Email string `validate:"email"`
+		Color string `validate:"iscolor"`
+	}
+
+	validate := New()
+	validate.RegisterErrorCode("required", "E001")
+	validate.RegisterErrorCode("iscolor", "E003")
+
+	errs := validate.Struct(Test{Email: "bad", Color: "bad"})
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+
+	fe := getError(errs, "Test.Name", "Test.Name")
+	Equal(t, fe.Code(), "E001")
+	Equal(t, fe.Tag(), "required")
+
+	fe = getError(errs, "Test.Email", "Test.Email")
+	Equal(t, fe.Code(), "email")
+
+	fe = getError(errs, "Test.Color", "Test.Color")
+	Equal(t, fe.Code(), "E003")
+
+	errs = New().Var("", "required")
+	NotEqual(t, errs, nil)
+	Equal(t, errs.(ValidationErrors)[0].Code(), "required")
+}
+
+func TestStructCacheConcurrency(t *testing.T) {
+	type First struct {
+		Name string `validate:"required"`
+	}
+	type Second struct {
+		Age int `validate:"gte=0,lte=130"`
+	}
+	type Third struct {
+		Inner *First `validate:"required"`
+	}
+
+	validate := New()
+
+	var wg sync.WaitGroup
+	var failures int32
+
+	for i := 0; i < 64; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			var errs error
+			switch i % 3 {
+			case 0:
+				errs = validate.Struct(First{})
+			case 1:
+				errs = validate.Struct(Second{Age: 200})
+			default:
+				errs = validate.Struct(Third{})
+			}
+
+			if errs == nil || len(errs.(ValidationErrors)) != 1 {
+				atomic.AddInt32(&failures, 1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	Equal(t, failures, int32(0))
+
+	for _, typ := range []reflect.Type{reflect.TypeOf(First{}), reflect.TypeOf(Second{}), reflect.TypeOf(Third{})} {
+		cs, ok := validate.structCache.Get(typ)
+		Equal(t, ok, true)
+		Equal(t, len(cs.fields), 1)
+	}
+}
+
+func TestMisbehavingCustomTypeFunc(t *testing.T) {
+	type Wrapped struct {
+		Value string
+	}
+
+	type Other struct {
+		Value string
+	}
+
+	type Test struct {
+		Wrapped Wrapped `validate:"required"`
+		Other   Other   `validate:"required"`
+		Name    string  `validate:"required"`
+	}
+
+	validate := New()
+
+	// returns the reflect.Value rather than the underlying value
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		return field.Field(0)
+	}, Wrapped{})
+
+	// returns the same type it was given
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		return field.Interface()
+	}, Other{})
+
+	errs := validate.Struct(Test{Wrapped: Wrapped{Value: "a"}, Other: Other{Value: "b"}})
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	AssertError(t, errs, "Test.Wrapped", "Test.Wrapped", "Wrapped", "Wrapped", "_customtype")
+	AssertError(t, errs, "Test.Other", "Test.Other", "Other", "Other", "_customtype")
+	AssertError(t, errs, "Test.Name", "Test.Name", "Name", "Name", "required")
+
+	fe := getError(errs, "Test.Wrapped", "Test.Wrapped")
+	Equal(t, fe.Param(), "validator.Wrapped")
+	Equal(t, fe.Type() == reflect.TypeOf(Wrapped{}), true)
+
+	fe = getError(errs, "Test.Other", "Test.Other")
+	Equal(t, fe.Param(), "validator.Other")
+
+	errs = validate.Var(Other{}, "required")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "_customtype")
+
+	PanicMatches(t, func() { _ = validate.RegisterValidation("_customtype", func(fl FieldLevel) bool { return true }) }, "Tag '_customtype' either contains restricted characters or is the same as a restricted tag needed for normal operation")
+}
+
+func TestRegisterCustomTypeFuncE(t *testing.T) {
+	type Money struct {
+		Raw string
+	}
+
+	type Order struct {
+		Total    Money  `validate:"required,gt=0"`
+		Discount *Money `validate:"omitempty,gte=0"`
+		Name     string `validate:"required"`
+	}
+
+	validate := New()
+	validate.RegisterCustomTypeFuncE(func(field reflect.Value) (interface{}, error) {
+		m := field.Interface().(Money)
+		f, err := strconv.ParseFloat(m.Raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed amount %q", m.Raw)
+		}
+		return f, nil
+	}, Money{})
+
+	errs := validate.Struct(Order{Total: Money{Raw: "9.99"}, Name: "books"})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Order{Total: Money{Raw: "-5"}, Discount: &Money{Raw: "-1"}, Name: "books"})
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Order.Total", "Order.Total", "Total", "Total", "gt")
+	AssertError(t, errs, "Order.Discount", "Order.Discount", "Discount", "Discount", "gte")
+
+	// a failed extraction is reported in place of validating the field
+	errs = validate.Struct(Order{Total: Money{Raw: "9.99.9"}, Discount: &Money{Raw: "ten"}})
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	AssertError(t, errs, "Order.Total", "Order.Total", "Total", "Total", "_customtype")
+	AssertError(t, errs, "Order.Discount", "Order.Discount", "Discount", "Discount", "_customtype")
+	AssertError(t, errs, "Order.Name", "Order.Name", "Name", "Name", "required")
+
+	fe := getError(errs, "Order.Total", "Order.Total")
+	Equal(t, fe.Param(), `malformed amount "9.99.9"`)
+	Equal(t, fe.Type() == reflect.TypeOf(Money{}), true)
+
+	errs = validate.Var(Money{Raw: "x"}, "required")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "_customtype")
+
+	// the non-error variant is unaffected
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		return field.Interface().(Money).Raw
+	}, Money{})
+
+	errs = validate.Var(Money{Raw: "x"}, "required")
+	Equal(t, errs, nil)
+}
+
+func TestRequiredNilableKinds(t *testing.T) {
+	type Deps struct {
+		Events  chan int         `validate:"required"`
+		Done    <-chan struct{}  `validate:"required"`
+		Handler func() error     `validate:"required"`
+		Lookup  map[string]int   `validate:"required"`
+		Items   []string         `validate:"required"`
+		EventsP *chan int        `validate:"required"`
+		Maybe   func(int) string `validate:"omitempty,required"`
+	}
+
+	validate := New()
+
+	errs := validate.Struct(Deps{})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 6)
+	AssertError(t, errs, "Deps.Events", "Deps.Events", "Events", "Events", "required")
+	AssertError(t, errs, "Deps.Done", "Deps.Done", "Done", "Done", "required")
+	AssertError(t, errs, "Deps.Handler", "Deps.Handler", "Handler", "Handler", "required")
+	AssertError(t, errs, "Deps.Lookup", "Deps.Lookup", "Lookup", "Lookup", "required")
+	AssertError(t, errs, "Deps.Items", "Deps.Items", "Items", "Items", "required")
+	AssertError(t, errs, "Deps.EventsP", "Deps.EventsP", "EventsP", "EventsP", "required")
+
+	events := make(chan int)
+	done := make(chan struct{})
+
+	// non-nil but empty values satisfy required
+	errs = validate.Struct(Deps{
+		Events:  events,
+		Done:    done,
+		Handler: func() error { return nil },
+		Lookup:  map[string]int{},
+		Items:   []string{},
+		EventsP: &events,
+	})
+	Equal(t, errs, nil)
+
+	var nilFunc func()
+	errs = validate.Var(nilFunc, "required")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "required")
+
+	errs = validate.Var(func() {}, "required")
+	Equal(t, errs, nil)
+
+	var nilChan chan int
+	errs = validate.Var(nilChan, "required")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "required")
+
+	errs = validate.Var(events, "required")
+	Equal(t, errs, nil)
+}
+
+func TestNamespaceSeparator(t *testing.T) {
+	type Address struct {
+		Street string `validate:"required"`
+	}
+
+	type User struct {
+		Name      string             `validate:"required"`
+		Addresses []Address          `validate:"required,dive"`
+		Tags      map[string]string  `validate:"dive,required"`
+		Nested    map[string][]int   `validate:"dive,dive,gt=0"`
+		Primary   *Address           `validate:"required"`
+		Extra     map[string]Address `validate:"omitempty,dive"`
+	}
+
+	user := User{
+		Addresses: []Address{{Street: "a"}, {}},
+		Tags:      map[string]string{"k": ""},
+		Nested:    map[string][]int{"n": {1, 0}},
+		Primary:   &Address{},
+	}
+
+	validate := New()
+	validate.SetNamespaceSeparator("/")
+
+	errs := validate.Struct(user)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 5)
+	AssertError(t, errs, "User/Name", "User/Name", "Name", "Name", "required")
+	AssertError(t, errs, "User/Addresses[1]/Street", "User/Addresses[1]/Street", "Street", "Street", "required")
+	AssertError(t, errs, "User/Tags[k]", "User/Tags[k]", "Tags[k]", "Tags[k]", "required")
+	AssertError(t, errs, "User/Nested[n][1]", "User/Nested[n][1]", "Nested[n][1]", "Nested[n][1]", "gt")
+	AssertError(t, errs, "User/Primary/Street", "User/Primary/Street", "Street", "Street", "required")
+
+	validate.SetNamespaceBrackets("/", "")
+
+	errs = validate.Struct(user)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 5)
+	AssertError(t, errs, "User/Addresses/1/Street", "User/Addresses/1/Street", "Street", "Street", "required")
+	AssertError(t, errs, "User/Tags/k", "User/Tags/k", "Tags/k", "Tags/k", "required")
+	AssertError(t, errs, "User/Nested/n/1", "User/Nested/n/1", "Nested/n/1", "Nested/n/1", "gt")
+
+	// StructPartial and StructExcept field names use the default syntax
+	errs = validate.StructPartial(user, "Addresses[1].Street", "Primary.Street")
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "User/Addresses/1/Street", "User/Addresses/1/Street", "Street", "Street", "required")
+	AssertError(t, errs, "User/Primary/Street", "User/Primary/Street", "Street", "Street", "required")
+
+	errs = validate.StructExcept(user, "Name", "Addresses[1].Street", "Tags", "Nested", "Primary.Street")
+	Equal(t, errs, nil)
+
+	errs = validate.StructFiltered(user, func(ns []byte) bool {
+		return !bytes.Equal(ns, []byte("User/Name"))
+	})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "User/Name", "User/Name", "Name", "Name", "required")
+
+	// restoring the defaults restores the original behaviour
+	validate.SetNamespaceSeparator(".")
+	validate.SetNamespaceBrackets("[", "]")
+
+	errs = validate.Struct(user)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "User.Addresses[1].Street", "User.Addresses[1].Street", "Street", "Street", "required")
+	AssertError(t, errs, "User.Nested[n][1]", "User.Nested[n][1]", "Nested[n][1]", "Nested[n][1]", "gt")
+
+	PanicMatches(t, func() { validate.SetNamespaceSeparator("") }, "Namespace separator cannot be empty")
+}
+
+func TestSetCacheSize(t *testing.T) {
+	type A struct {
+		Name string `validate:"required"`
+	}
+	type B struct {
+		Name string `validate:"required"`
+	}
+	type C struct {
+		Name string `validate:"required"`
+	}
+	type D struct {
+		Inner A `validate:"required"`
+	}
+
+	cacheLen := func(v *Validate) int {
+		return len(v.structCache.m.Load().(map[reflect.Type]*cStruct))
+	}
+
+	validate := New()
+	validate.SetCacheSize(2)
+
+	for i := 0; i < 3; i++ {
+		NotEqual(t, validate.Struct(A{}), nil)
+		NotEqual(t, validate.Struct(B{}), nil)
+		NotEqual(t, validate.Struct(C{}), nil)
+		Equal(t, cacheLen(validate), 2)
+	}
+
+	_, ok := validate.structCache.Get(reflect.TypeOf(A{}))
+	Equal(t, ok, false)
+
+	// B is used most recently, so C is evicted by A
+	NotEqual(t, validate.Struct(B{}), nil)
+	NotEqual(t, validate.Struct(A{}), nil)
+	_, ok = validate.structCache.Get(reflect.TypeOf(C{}))
+	Equal(t, ok, false)
+	_, ok = validate.structCache.Get(reflect.TypeOf(B{}))
+	Equal(t, ok, true)
+
+	// nested types still validate when their parent is evicted mid validation
+	validate.SetCacheSize(1)
+	Equal(t, cacheLen(validate), 0)
+
+	errs := validate.Struct(D{})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "D.Inner.Name", "D.Inner.Name", "Name", "Name", "required")
+	Equal(t, cacheLen(validate), 1)
+
+	validate.SetCacheSize(0)
+	NotEqual(t, validate.Struct(A{}), nil)
+	NotEqual(t, validate.Struct(B{}), nil)
+	NotEqual(t, validate.Struct(C{}), nil)
+	Equal(t, cacheLen(validate), 3)
+}
+
+func TestErrorOrderDeterministic(t *testing.T) {
+	type Leaf struct {
+		A string `validate:"required"`
+		B string `validate:"required"`
+	}
+
+	type Middle struct {
+		First  string          `validate:"required"`
+		Leaf   Leaf            `validate:"required"`
+		Leaves map[string]Leaf `validate:"dive"`
+		Last   string          `validate:"required"`
+	}
+
+	type Top struct {
+		Name    string         `validate:"required"`
+		Middle  Middle         `validate:"required"`
+		Items   []Leaf         `validate:"dive"`
+		Scores  map[int]int    `validate:"dive,gt=0"`
+		Trailer string         `validate:"required"`
+		Labels  map[string]int `validate:"dive,keys,len=1,endkeys,gt=0"`
+	}
+
+	validate := New(WithSortedMapKeys())
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(sl.Current().Interface(), "Middle", "Middle", "middle", "")
+	}, Middle{})
+
+	top := Top{
+		Middle: Middle{
+			Leaves: map[string]Leaf{"z": {A: "a"}, "a": {B: "b"}, "m": {}},
+		},
+		Items:  []Leaf{{A: "a"}, {}, {B: "b"}},
+		Scores: map[int]int{10: 0, -1: 0, 2: 0},
+		Labels: map[string]int{"bb": 1, "a": 0},
+	}
+
+	expected := []string{
+		"Top.Name",
+		"Top.Middle.First",
+		"Top.Middle.Leaf.A",
+		"Top.Middle.Leaf.B",
+		"Top.Middle.Leaves[a].A",
+		"Top.Middle.Leaves[m].A",
+		"Top.Middle.Leaves[m].B",
+		"Top.Middle.Leaves[z].B",
+		"Top.Middle.Last",
+		"Top.Middle.Middle",
+		"Top.Items[0].B",
+		"Top.Items[1].A",
+		"Top.Items[1].B",
+		"Top.Items[2].A",
+		"Top.Scores[-1]",
+		"Top.Scores[2]",
+		"Top.Scores[10]",
+		"Top.Trailer",
+		"Top.Labels[a]",
+		"Top.Labels[bb]",
+	}
+
+	for i := 0; i < 10; i++ {
+		errs := validate.Struct(top)
+		NotEqual(t, errs, nil)
+
+		ve := errs.(ValidationErrors)
+		actual := make([]string, len(ve))
+		for j := 0; j < len(ve); j++ {
+			actual[j] = ve[j].Namespace()
+		}
+
+		Equal(t, actual, expected)
+	}
+
+	// keys other than numbers, strings and bools are ordered by their contents
+	type point struct {
+		X, Y int
+	}
+
+	keyed := map[point]int{{2, 1}: 0, {1, 2}: 0, {1, 1}: 0}
+	pairs := map[[2]string]int{{"b", "a"}: 0, {"a", "b"}: 0}
+	mixed := map[interface{}]int{"b": 0, 1: 0, "a": 0, nil: 0}
+
+	for i := 0; i < 10; i++ {
+		errs := validate.Var(keyed, "dive,gt=0")
+		NotEqual(t, errs, nil)
+		ve := errs.(ValidationErrors)
+		Equal(t, ve[0].Namespace(), "[{1 1}]")
+		Equal(t, ve[1].Namespace(), "[{1 2}]")
+		Equal(t, ve[2].Namespace(), "[{2 1}]")
+
+		errs = validate.Var(pairs, "dive,gt=0")
+		NotEqual(t, errs, nil)
+		ve = errs.(ValidationErrors)
+		Equal(t, ve[0].Namespace(), "[[a b]]")
+		Equal(t, ve[1].Namespace(), "[[b a]]")
+
+		errs = validate.Var(mixed, "dive,gt=0")
+		NotEqual(t, errs, nil)
+		ve = errs.(ValidationErrors)
+		Equal(t, ve[0].Namespace(), "[<nil>]")
+		Equal(t, ve[1].Namespace(), "[1]")
+		Equal(t, ve[2].Namespace(), "[a]")
+		Equal(t, ve[3].Namespace(), "[b]")
+	}
+}
+
+func TestSetDefaultFieldTag(t *testing.T) {
+	type Embedded struct {
+		Host string
+		Port int `validate:"omitempty,gt=0"`
+	}
+
+	type Config struct {
+		Embedded
+		Name     string
+		Optional string `validate:"-"`
+		Explicit string `validate:"omitempty,min=3"`
+		Inner    struct {
+			Key string
+		}
+		unexported string
+	}
+
+	validate := New()
+	validate.SetDefaultFieldTag("required")
+
+	errs := validate.Struct(Config{Explicit: "ab"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 4)
+	AssertError(t, errs, "Config.Embedded.Host", "Config.Embedded.Host", "Host", "Host", "required")
+	AssertError(t, errs, "Config.Name", "Config.Name", "Name", "Name", "required")
+	AssertError(t, errs, "Config.Explicit", "Config.Explicit", "Explicit", "Explicit", "min")
+	AssertError(t, errs, "Config.Inner.Key", "Config.Inner.Key", "Key", "Key", "required")
+
+	cfg := Config{Name: "name"}
+	cfg.Host = "localhost"
+	cfg.Inner.Key = "key"
+
+	errs = validate.Struct(cfg)
+	Equal(t, errs, nil)
+
+	// default behaviour is to not validate untagged fields
+	errs = New().Struct(Config{Explicit: "ab"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "Config.Explicit", "Config.Explicit", "Explicit", "Explicit", "min")
+}
+
+func TestCrossFieldMapAndSliceReferences(t *testing.T) {
+	type Key string
+
+	type Test struct {
+		Limits  map[string]int
+		Named   map[Key]int
+		ByID    map[int8]int
+		Steps   []int
+		Nested  []map[string]int
+		Max     int `validate:"eqfield=Limits[max]"`
+		Min     int `validate:"ltfield=Limits[max],gtefield=Steps[0]"`
+		Second  int `validate:"eqfield=Steps[1]"`
+		NamedEq int `validate:"eqfield=Named[a]"`
+		IDEq    int `validate:"eqfield=ByID[-3]"`
+		Deep    int `validate:"eqfield=Nested[0][x]"`
+	}
+
+	validate := New()
+
+	test := Test{
+		Limits:  map[string]int{"max": 10},
+		Named:   map[Key]int{"a": 1},
+		ByID:    map[int8]int{-3: 2},
+		Steps:   []int{1, 5},
+		Nested:  []map[string]int{{"x": 7}},
+		Max:     10,
+		Min:     1,
+		Second:  5,
+		NamedEq: 1,
+		IDEq:    2,
+		Deep:    7,
+	}
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test.Max = 9
+	test.Min = 0
+	test.Second = 4
+	test.NamedEq = 2
+	test.IDEq = 3
+	test.Deep = 8
+
+	errs = validate.Struct(test)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 6)
+	AssertError(t, errs, "Test.Max", "Test.Max", "Max", "Max", "eqfield")
+	AssertError(t, errs, "Test.Min", "Test.Min", "Min", "Min", "gtefield")
+	AssertError(t, errs, "Test.Second", "Test.Second", "Second", "Second", "eqfield")
+	AssertError(t, errs, "Test.NamedEq", "Test.NamedEq", "NamedEq", "NamedEq", "eqfield")
+	AssertError(t, errs, "Test.IDEq", "Test.IDEq", "IDEq", "IDEq", "eqfield")
+	AssertError(t, errs, "Test.Deep", "Test.Deep", "Deep", "Deep", "eqfield")
+
+	// missing keys and out of range indexes are not found and fail validation
+	test = Test{Max: 10, Min: 1, Second: 5, NamedEq: 1, IDEq: 2, Deep: 7}
+
+	errs = validate.Struct(test)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 6)
+	AssertError(t, errs, "Test.Max", "Test.Max", "Max", "Max", "eqfield")
+	AssertError(t, errs, "Test.Second", "Test.Second", "Second", "Second", "eqfield")
+	AssertError(t, errs, "Test.Deep", "Test.Deep", "Deep", "Deep", "eqfield")
+
+	type BadIndex struct {
+		Steps []int
+		Value int `validate:"eqfield=Steps[first]"`
+	}
+
+	PanicMatches(t, func() { _ = validate.Struct(BadIndex{Steps: []int{1}}) }, "Invalid index 'first' in field namespace")
+
+	type BadKey struct {
+		ByID  map[int]int
+		Value int `validate:"eqfield=ByID[one]"`
+	}
+
+	PanicMatches(t, func() { _ = validate.Struct(BadKey{ByID: map[int]int{1: 1}}) }, "Invalid map key 'one' in field namespace: strconv.ParseInt: parsing \"one\": invalid syntax")
+}
+
+func TestStructSkippingTag(t *testing.T) {
+	type Secret struct {
+		Value string `validate:"required"`
+		Hint  string `validate:"required" sensitive:"true"`
+	}
+
+	type Test struct {
+		Name     string            `validate:"required"`
+		Password string            `validate:"required,min=8" sensitive:"true"`
+		Notes    string            `validate:"required" sensitive:""`
+		Secret   Secret            `validate:"required" sensitive:"yes"`
+		Secrets  []Secret          `validate:"dive"`
+		Tokens   map[string]string `validate:"dive,required" sensitive:"true"`
+	}
+
+	validate := New()
+
+	test := Test{
+		Secrets: []Secret{{}},
+		Tokens:  map[string]string{"a": ""},
+	}
+
+	errs := validate.StructSkippingTag(test, "sensitive")
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "Test.Name", "Test.Name", "Name", "Name", "required")
+	AssertError(t, errs, "Test.Notes", "Test.Notes", "Notes", "Notes", "required")
+	AssertError(t, errs, "Test.Secrets[0].Value", "Test.Secrets[0].Value", "Value", "Value", "required")
+
+	errs = validate.StructSkippingTagCtx(context.Background(), &test, "sensitive")
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+
+	// the skip tag must not leak into subsequent validations using the pooled validate
+	errs = validate.Struct(test)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 8)
+
+	errs = validate.StructSkippingTag(1, "sensitive")
+	NotEqual(t, errs, nil)
+	Equal(t, errs.Error(), "validator: expected a struct but got int of kind int, use Var to validate a single variable")
+}
+
+func TestSkipIfSkipUnless(t *testing.T) {
+	type Inner struct {
+		Host string `validate:"required,hostname"`
+	}
+
+	type Test struct {
+		Enabled  bool
+		Kind     string
+		Endpoint string   `validate:"skip_unless=Enabled true,required,url"`
+		Email    *string  `validate:"skip_if=Enabled false,required,email"`
+		Both     string   `validate:"skip_if=Enabled false Kind internal,required,min=5"`
+		Inner    *Inner   `validate:"skip_unless=Enabled true,required"`
+		Tags     []string `validate:"skip_unless=Enabled true,dive,alpha"`
+	}
+
+	validate := New()
+
+	// gate false, all validations including format checks are skipped
+	bad := "not an email"
+	test := Test{Kind: "internal", Endpoint: "not a url", Email: &bad, Tags: []string{"1"}}
+
+	errs := validate.Struct(test)
+	Equal(t, errs, nil)
+
+	test.Enabled = true
+
+	errs = validate.Struct(test)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 5)
+	AssertError(t, errs, "Test.Endpoint", "Test.Endpoint", "Endpoint", "Endpoint", "url")
+	AssertError(t, errs, "Test.Email", "Test.Email", "Email", "Email", "email")
+	AssertError(t, errs, "Test.Both", "Test.Both", "Both", "Both", "required")
+	AssertError(t, errs, "Test.Inner", "Test.Inner", "Inner", "Inner", "required")
+	AssertError(t, errs, "Test.Tags[0]", "Test.Tags[0]", "Tags[0]", "Tags[0]", "alpha")
+
+	test.Email = nil
+	test.Inner = &Inner{}
+
+	errs = validate.Struct(test)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Email", "Test.Email", "Email", "Email", "required")
+	AssertError(t, errs, "Test.Inner.Host", "Test.Inner.Host", "Host", "Host", "required")
+
+	// skip_if requires all pairs to match
+	test = Test{Kind: "external", Both: "abc"}
+	errs = validate.Struct(test)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "Test.Both", "Test.Both", "Both", "Both", "min")
+
+	type Bad struct {
+		Enabled bool
+		Field   string `validate:"skip_if=Enabled,required"`
+	}
+
+	PanicMatches(t, func() { _ = validate.Struct(Bad{}) }, "Bad param number for skip_if Field")
+}
+
+type methodOrder struct {
+	Items []int `validate:"method=TotalValid"`
+	Total int
+	Code  methodCode  `validate:"method=Valid"`
+	Ptr   *methodCode `validate:"omitempty,method=Valid"`
+}
+
+func (o methodOrder) TotalValid() bool {
+	sum := 0
+	for _, i := range o.Items {
+		sum += i
+	}
+	return sum == o.Total
+}
+
+type methodRange struct {
+	Min, Max int `validate:"method=Valid"`
+}
+
+func (r *methodRange) Valid() bool {
+	return r.Min <= r.Max
+}
+
+type methodCode string
+
+func (c methodCode) Valid() bool {
+	return len(c) == 3
+}
+
+type methodBadSignature struct {
+	Name string `validate:"method=Check"`
+}
+
+func (methodBadSignature) Check(s string) bool {
+	return s != ""
+}
+
+type BaseModel struct {
+	ID        int       `validate:"gt=0"`
+	CreatedAt time.Time `validate:"required"`
+}
+
+type Audit struct {
+	By string `validate:"required"`
+}
+
+type heterogeneousUser struct {
+	Name string `validate:"required"`
+}
+
+type heterogeneousOrder struct {
+	ID int `validate:"gt=0"`
+}
+
+func TestSetStrictCrossField(t *testing.T) {
+	type Inner struct {
+		Name string
+	}
+
+	type Test struct {
+		Password string
+		Confirm  string `validate:"eqfield=Pasword"`
+		Other    string `validate:"nefield=Pasword"`
+		Limit    int
+		Count    int `validate:"ltefield=Limit"`
+		Inner    *Inner
+		Name     string `validate:"nefield=Inner.Name"`
+		Missing  string `validate:"nefield=Inner.Nmae"`
+	}
+
+	tst := Test{Password: "secret", Confirm: "secret", Other: "x", Limit: 5, Count: 1}
+
+	validate := New()
+
+	// silently fails and passes by default
+	errs := validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Test.Confirm", "Test.Confirm", "Confirm", "Confirm", "eqfield")
+
+	validate.SetStrictCrossField(true)
+
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Test.Confirm", "Test.Confirm", "Confirm", "Confirm", "_badcrossfield")
+	AssertError(t, errs, "Test.Other", "Test.Other", "Other", "Other", "_badcrossfield")
+	Equal(t, ve[0].Param(), "Pasword")
+	Equal(t, ve[0].ActualTag(), "_badcrossfield")
+
+	// existing fields which can't be reached, through a nil pointer, aren't bad references
+	tst.Inner = &Inner{Name: "joeybloggs"}
+	tst.Name = "joeybloggs"
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 4)
+	AssertError(t, errs, "Test.Name", "Test.Name", "Name", "Name", "nefield")
+	AssertError(t, errs, "Test.Missing", "Test.Missing", "Missing", "Missing", "_badcrossfield")
+	Equal(t, ve[3].Param(), "Inner.Nmae")
+
+	// or'd validations, skip_if and those run against struct values report bad references too
+	type Address struct {
+		Street string
+	}
+
+	type Other struct {
+		Password string
+		Either   string  `validate:"eqfield=Pasword|eq=secret"`
+		Skipped  string  `validate:"skip_if=Pasword x,required"`
+		Billing  Address `validate:"required_with=Shiping"`
+	}
+
+	validate = New(WithRequiredStructEnabled())
+	validate.SetStrictCrossField(true)
+
+	errs = validate.Struct(Other{Password: "x", Either: "secret", Skipped: "y", Billing: Address{Street: "Main"}})
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Other.Skipped", "Other.Skipped", "Skipped", "Skipped", "_badcrossfield")
+	AssertError(t, errs, "Other.Billing", "Other.Billing", "Billing", "Billing", "_badcrossfield")
+	Equal(t, ve[0].Param(), "Pasword x")
+	Equal(t, ve[1].Param(), "Shiping")
+
+	// another or'd validation passing still satisfies the field, otherwise the bad reference is reported
+	errs = validate.Struct(Other{Password: "x", Either: "nope", Skipped: "y", Billing: Address{Street: "Main"}})
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	AssertError(t, errs, "Other.Either", "Other.Either", "Either", "Either", "_badcrossfield")
+	Equal(t, ve[0].Param(), "Pasword")
+
+	PanicMatches(t, func() { _ = validate.RegisterValidation("_badcrossfield", func(fl FieldLevel) bool { return true }) },
+		"Tag '_badcrossfield' either contains restricted characters or is the same as a restricted tag needed for normal operation")
+}
+
+func TestDeregisterValidation(t *testing.T) {
+	validate := New()
+
+	err := validate.RegisterValidation("plugin", func(fl FieldLevel) bool {
+		return fl.Field().String() == "ok"
+	})
+	Equal(t, err, nil)
+
+	type Test struct {
+		Value string `validate:"plugin"`
+	}
+
+	errs := validate.Struct(Test{Value: "nok"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Value", "Test.Value", "Value", "Value", "plugin")
+
+	errs = validate.Var("nok", "plugin")
+	NotEqual(t, errs, nil)
+
+	Equal(t, validate.DeregisterValidation("plugin"), true)
+	Equal(t, validate.DeregisterValidation("plugin"), false)
+	Equal(t, validate.DeregisterValidation("unknown"), false)
+
+	// cached tags and structs don't keep using the deregistered validation
+	PanicMatches(t, func() { _ = validate.Struct(Test{Value: "nok"}) }, "Undefined validation function 'plugin' on field 'Value'")
+	PanicMatches(t, func() { _ = validate.Var("nok", "plugin") }, "Undefined validation function 'plugin' on field ''")
+
+	// baked in validations, even when overridden, are only removed by ForceDeregisterValidation
+	Equal(t, validate.DeregisterValidation("email"), false)
+	Equal(t, validate.Var("not an email", "email") != nil, true)
+
+	err = validate.RegisterValidation("email", func(fl FieldLevel) bool { return false })
+	Equal(t, err, nil)
+	Equal(t, validate.DeregisterValidation("email"), false)
+
+	Equal(t, validate.ForceDeregisterValidation("email"), true)
+	PanicMatches(t, func() { _ = validate.Var("joeybloggs@example.com", "email") }, "Undefined validation function 'email' on field ''")
+
+	// other instances are unaffected
+	Equal(t, New().Var("joeybloggs@example.com", "email"), nil)
+}
+
+func TestDeregisterAlias(t *testing.T) {
+	validate := New()
+	validate.RegisterAlias("username", "required,alphanum,min=3")
+
+	errs := validate.Var("ab", "username")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "username")
+
+	Equal(t, validate.DeregisterAlias("username"), true)
+	Equal(t, validate.DeregisterAlias("username"), false)
+
+	PanicMatches(t, func() { _ = validate.Var("ab", "username") }, "Undefined validation function 'username' on field ''")
+
+	Equal(t, validate.DeregisterAlias("iscolor"), false)
+	Equal(t, validate.Var("#fff", "iscolor"), nil)
+
+	Equal(t, validate.ForceDeregisterAlias("iscolor"), true)
+	PanicMatches(t, func() { _ = validate.Var("#fff", "iscolor") }, "Undefined validation function 'iscolor' on field ''")
+}
+
+func TestDeregisterKeepsCacheSize(t *testing.T) {
+	type A struct {
+		Name string `validate:"required"`
+	}
+	type B struct {
+		Name string `validate:"required"`
+	}
+
+	validate := New()
+	validate.SetCacheSize(1)
+	validate.RegisterAlias("username", "required")
+
+	NotEqual(t, validate.Struct(A{}), nil)
+	Equal(t, validate.DeregisterAlias("username"), true)
+	Equal(t, validate.structCache.maxSize, 1)
+
+	_, ok := validate.structCache.Get(reflect.TypeOf(A{}))
+	Equal(t, ok, false)
+
+	NotEqual(t, validate.Struct(A{}), nil)
+	NotEqual(t, validate.Struct(B{}), nil)
+	Equal(t, len(validate.structCache.m.Load().(map[reflect.Type]*cStruct)), 1)
+}
+
+func TestStructMap(t *testing.T) {
+	validate := New()
+
+	type ServerConfig struct {
+		Host string `validate:"required,hostname"`
+		Port int    `validate:"gte=1,lte=65535"`
+	}
+
+	configs := map[string]ServerConfig{
+		"secondary": {Host: "", Port: 8080},
+		"primary":   {Host: "example.com", Port: 0},
+		"backup":    {Host: "backup.example.com", Port: 443},
+	}
+
+	errs := validate.StructMap(configs)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	Equal(t, ve[0].Namespace(), "ServerConfig[primary].Port")
+	Equal(t, ve[0].StructNamespace(), "ServerConfig[primary].Port")
+	Equal(t, ve[0].Tag(), "gte")
+	Equal(t, ve[1].Namespace(), "ServerConfig[secondary].Host")
+	Equal(t, ve[1].Tag(), "required")
+
+	errs = validate.StructMap(map[string]ServerConfig{"backup": configs["backup"]})
+	Equal(t, errs, nil)
+
+	errs = validate.StructMap(map[int]*ServerConfig{2: {Host: "example.com"}})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "ServerConfig[2].Port", "ServerConfig[2].Port", "Port", "Port", "gte")
+
+	errs = validate.StructMap(&configs)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+
+	errs = validate.StructMap(map[string]int{"a": 1})
+	NotEqual(t, errs, nil)
+	Equal(t, IsInvalidValidationError(errs), true)
+	Equal(t, errs.Error(), "validator: expected a struct but got int of kind int, use Var to validate a single variable")
+
+	errs = validate.StructMap(configs["primary"])
+	NotEqual(t, errs, nil)
+	Equal(t, IsInvalidValidationError(errs), true)
+
+	errs = validate.StructMap(nil)
+	NotEqual(t, errs, nil)
+	Equal(t, errs.Error(), "validator: (nil)")
+}
+
+func TestNewOptions(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	validate := New(
+		WithTagName("binding"),
+		WithJSONTagNames(),
+		WithNowFunc(func() time.Time { return now }),
+	)
+
+	type Login struct {
+		Username string    `json:"username,omitempty" binding:"required"`
+		Password string    `json:"-" binding:"required"`
+		Expires  time.Time `json:"expires" binding:"future"`
+		Ignored  string    `validate:"required"`
+	}
+
+	errs := validate.Struct(Login{Expires: now})
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	AssertError(t, errs, "Login.username", "Login.Username", "username", "Username", "required")
+	AssertError(t, errs, "Login.Password", "Login.Password", "Password", "Password", "required")
+	AssertError(t, errs, "Login.expires", "Login.Expires", "expires", "Expires", "future")
+
+	errs = validate.Struct(Login{Username: "joeybloggs", Password: "secret", Expires: now.Add(time.Hour)})
+	Equal(t, errs, nil)
+
+	// zero options keeps the defaults
+	validate = New()
+	errs = validate.Struct(Login{})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Login.Ignored", "Login.Ignored", "Ignored", "Ignored", "required")
+}
+
+func TestWithRequiredStructEnabled(t *testing.T) {
+	type Address struct {
+		Street string
+		Tags   []string
+	}
+
+	type User struct {
+		Name    string
+		Address Address `validate:"required"`
+		Other   Address
+	}
+
+	// by default required isn't run against non-pointer structs
+	errs := New().Struct(User{})
+	Equal(t, errs, nil)
+
+	validate := New(WithRequiredStructEnabled())
+
+	errs = validate.Struct(User{})
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "User.Address", "User.Address", "Address", "Address", "required")
+
+	errs = validate.Struct(User{Address: Address{Tags: []string{"home"}}})
+	Equal(t, errs, nil)
+
+	errs = validate.Var(Address{}, "required")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "required")
+
+	errs = validate.Var(Address{Street: "Main"}, "required")
+	Equal(t, errs, nil)
+
+	// only the required validations are run against the struct value
+	type Contact struct {
+		Street string
+	}
+
+	type Order struct {
+		Billing  Contact `validate:"required_with=Shipping"`
+		Shipping Contact `validate:"email"`
+	}
+
+	errs = validate.Struct(Order{})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Order{Shipping: Contact{Street: "Main"}})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "Order.Billing", "Order.Billing", "Billing", "Billing", "required_with")
+}
+
+func TestCrossFieldMixedNumericKinds(t *testing.T) {
+	validate := New()
+
+	type Limits struct {
+		MinAge  int8
+		MaxAge  uint16
+		Minimum float32
+		Age     int     `validate:"gtefield=MinAge,ltefield=MaxAge"`
+		Count   uint64  `validate:"gtfield=MinAge,ltfield=MaxAge"`
+		Score   int32   `validate:"gtefield=Minimum"`
+		Ratio   float64 `validate:"eqfield=Minimum"`
+		Other   uint8   `validate:"nefield=Age"`
+	}
+
+	l := Limits{MinAge: 18, MaxAge: 65, Minimum: 2.5, Age: 30, Count: 19, Score: 3, Ratio: 2.5, Other: 29}
+	errs := validate.Struct(l)
+	Equal(t, errs, nil)
+
+	l = Limits{MinAge: 18, MaxAge: 65, Minimum: 2.5, Age: 17, Count: 65, Score: 2, Ratio: 2.25, Other: 17}
+	errs = validate.Struct(l)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 5)
+	AssertError(t, errs, "Limits.Age", "Limits.Age", "Age", "Age", "gtefield")
+	AssertError(t, errs, "Limits.Count", "Limits.Count", "Count", "Count", "ltfield")
+	AssertError(t, errs, "Limits.Score", "Limits.Score", "Score", "Score", "gtefield")
+	AssertError(t, errs, "Limits.Ratio", "Limits.Ratio", "Ratio", "Ratio", "eqfield")
+	AssertError(t, errs, "Limits.Other", "Limits.Other", "Other", "Other", "nefield")
+
+	// negative signed values are always less than unsigned ones
+	type Signed struct {
+		Max uint
+		Min int `validate:"ltfield=Max"`
+	}
+
+	errs = validate.Struct(Signed{Max: 0, Min: -1})
+	Equal(t, errs, nil)
+
+	type Unsigned struct {
+		Min int
+		Val uint `validate:"gtfield=Min"`
+	}
+
+	errs = validate.Struct(Unsigned{Min: -5, Val: 0})
+	Equal(t, errs, nil)
+
+	errs = validate.VarWithValue(uint32(5), int64(5), "eqfield")
+	Equal(t, errs, nil)
+
+	errs = validate.VarWithValue(5, 5.5, "ltfield")
+	Equal(t, errs, nil)
+
+	errs = validate.VarWithValue(5, 5.5, "gtefield")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "gtefield")
+}
+
+func TestSetFlattenEmbedded(t *testing.T) {
+	type Base struct {
+		ID int `validate:"gt=0" json:"id"`
+	}
+
+	type Meta struct {
+		Version int `validate:"gte=1"`
+	}
+
+	type Named struct {
+		Base Base
+	}
+
+	type User struct {
+		Base
+		*Meta `validate:"required"`
+		Named
+		Name string `validate:"required" json:"name"`
+	}
+
+	u := User{Named: Named{Base: Base{ID: 1}}}
+
+	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return fld.Tag.Get("json")
+	})
+
+	errs := validate.Struct(u)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "User.Base.id", "User.Base.ID", "id", "ID", "gt")
+	AssertError(t, errs, "User.Meta", "User.Meta", "Meta", "Meta", "required")
+	AssertError(t, errs, "User.name", "User.Name", "name", "Name", "required")
+
+	validate.SetFlattenEmbedded(true)
+
+	errs = validate.Struct(u)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "User.id", "User.ID", "id", "ID", "gt")
+	AssertError(t, errs, "User.Meta", "User.Meta", "Meta", "Meta", "required")
+	AssertError(t, errs, "User.name", "User.Name", "name", "Name", "required")
+
+	u.Meta = &Meta{}
+	u.Named.Base.ID = 0
+
+	errs = validate.Struct(u)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 4)
+	AssertError(t, errs, "User.Version", "User.Version", "Version", "Version", "gte")
+	// only embedded structs are flattened, not named struct fields within them
+	AssertError(t, errs, "User.Base.id", "User.Base.ID", "id", "ID", "gt")
+
+	errs = validate.StructPartial(u, "ID")
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "User.id", "User.ID", "id", "ID", "gt")
+}
+
+func TestDiveInterfaceElements(t *testing.T) {
+	type Collection struct {
+		Items  []interface{}          `validate:"dive"`
+		Lookup map[string]interface{} `validate:"dive"`
+	}
+
+	var structLevelCalls int
+
+	validate := New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		structLevelCalls++
+		if sl.Current().Interface().(heterogeneousOrder).ID > 100 {
+			sl.ReportError(sl.Current().Field(0).Interface(), "ID", "ID", "maxid", "")
+		}
+	}, heterogeneousOrder{})
+
+	c := Collection{
+		Items:  []interface{}{heterogeneousUser{}, &heterogeneousOrder{}, nil, "string", heterogeneousOrder{ID: 101}},
+		Lookup: map[string]interface{}{"user": &heterogeneousUser{}, "nil": nil},
+	}
+
+	errs := validate.Struct(c)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 4)
+	AssertError(t, errs, "Collection.Items[0].Name", "Collection.Items[0].Name", "Name", "Name", "required")
+	AssertError(t, errs, "Collection.Items[1].ID", "Collection.Items[1].ID", "ID", "ID", "gt")
+	AssertError(t, errs, "Collection.Items[4].ID", "Collection.Items[4].ID", "ID", "ID", "maxid")
+	AssertError(t, errs, "Collection.Lookup[user].Name", "Collection.Lookup[user].Name", "Name", "Name", "required")
+	Equal(t, structLevelCalls, 2)
+
+	c = Collection{
+		Items:  []interface{}{heterogeneousUser{Name: "joey"}, &heterogeneousOrder{ID: 1}, nil},
+		Lookup: map[string]interface{}{"nil": nil},
+	}
+	Equal(t, validate.Struct(c), nil)
+}
+
+func TestNamedDatetimeValidation(t *testing.T) {
+	tests := []struct {
+		value    string
+		tag      string
+		expected bool
+	}{
+		{"2008-02-01T15:04:05Z", "rfc3339", true},
+		{"2008-02-01T15:04:05+07:00", "rfc3339", true},
+		{"2008-02-01T15:04:05.123Z", "rfc3339", true},
+		{"2008-02-01 15:04:05Z", "rfc3339", false},
+		{"2008-02-01T15:04:05", "rfc3339", false},
+		{"2008-02-01", "rfc3339", false},
+		{"2008-02-01T15:04:05.999999999Z", "rfc3339_nano", true},
+		{"2008-02-01T15:04:05-03:00", "rfc3339_nano", true},
+		{"2008-02-01T15:04:05.1", "rfc3339_nano", false},
+		{"2008-02-01", "date", true},
+		{"2008-2-1", "date", false},
+		{"2008-02-30", "date", false},
+		{"02/01/2008", "date", false},
+		{"2008-02-01T15:04:05Z", "date", false},
+		{"15:04:05", "time", true},
+		{"23:59:59", "time", true},
+		{"24:00:00", "time", false},
+		{"15:04", "time", false},
+		{"3:04PM", "time", false},
+		{"", "time", false},
+	}
+
+	validate := New()
+
+	for i, test := range tests {
+
+		errs := validate.Var(test.value, test.tag)
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d %s failed Error: %s", i, test.tag, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d %s failed Error: %s", i, test.tag, errs)
+			} else {
+				val := getError(errs, "", "")
+				if val.Tag() != test.tag {
+					t.Fatalf("Index: %d %s failed Error: %s", i, test.tag, errs)
+				}
+			}
+		}
+	}
+
+	// []byte fields are validated as their contents, as for datetime
+	Equal(t, validate.Var([]byte("2008-02-01T15:04:05Z"), "rfc3339"), nil)
+	Equal(t, validate.Var([]byte("2008-02-01T15:04:05.999999999Z"), "rfc3339_nano"), nil)
+	Equal(t, validate.Var([]byte("2008-02-01"), "date"), nil)
+	Equal(t, validate.Var([]byte("15:04:05"), "time"), nil)
+	AssertError(t, validate.Var([]byte("2008-02-30"), "date"), "", "", "", "", "date")
+
+	PanicMatches(t, func() { _ = validate.Var(2, "date") }, "Bad field type int")
+}
+
+func TestStructBatch(t *testing.T) {
+	type Item struct {
+		ID   int    `validate:"gt=0"`
+		Name string `validate:"required"`
+	}
+
+	validate := New()
+
+	items := make([]interface{}, 1000)
+	for i := range items {
+		items[i] = &Item{ID: i, Name: strconv.Itoa(i)}
+	}
+	items[500] = "bad"
+
+	for _, concurrency := range []int{0, 1, 8, 2000} {
+		errs := validate.StructBatch(items, concurrency)
+		Equal(t, len(errs), len(items))
+
+		for i, err := range errs {
+			switch i {
+			case 0:
+				AssertError(t, err, "Item.ID", "Item.ID", "ID", "ID", "gt")
+			case 500:
+				Equal(t, IsInvalidValidationError(err), true)
+			default:
+				if err != nil {
+					t.Fatalf("Index: %d unexpected error: %s", i, err)
+				}
+			}
+		}
+	}
+
+	Equal(t, len(validate.StructBatch(nil, 4)), 0)
+
+	// misconfiguration panics reach the caller as for Struct
+	type Bad struct {
+		Name string `validate:"undefinedtag"`
+	}
+
+	bad := []interface{}{&Item{ID: 1, Name: "a"}, &Bad{}, &Item{ID: 2, Name: "b"}}
+	for _, concurrency := range []int{1, 3} {
+		PanicMatches(t, func() { _ = validate.StructBatch(bad, concurrency) }, "Undefined validation function 'undefinedtag' on field 'Name'")
+	}
+
+	// items aren't validated once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errs := validate.StructBatchCtx(ctx, items[:10], 2)
+	for _, err := range errs {
+		Equal(t, err, context.Canceled)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	type Cancelling struct {
+		Name string `validate:"cancel"`
+	}
+
+	err := validate.RegisterValidationCtx("cancel", func(ctx context.Context, fl FieldLevel) bool {
+		cancel()
+		return true
+	})
+	Equal(t, err, nil)
+
+	errs = validate.StructBatchCtx(ctx, []interface{}{&Cancelling{}, &Cancelling{}, &Cancelling{}}, 1)
+	Equal(t, errs[0], nil)
+	Equal(t, errs[1], context.Canceled)
+	Equal(t, errs[2], context.Canceled)
+}
+
+func TestFieldErrorValueString(t *testing.T) {
+	type Credentials struct {
+		Username string   `validate:"email"`
+		Password string   `validate:"min=20" sensitive:"true"`
+		Tokens   []string `validate:"dive,len=10" sensitive:"true"`
+		Bio      string   `validate:"max=10"`
+		Age      *int     `validate:"required"`
+	}
+
+	validate := New()
+	validate.SetSensitiveTag("sensitive")
+
+	long := strings.Repeat("é", 70)
+
+	errs := validate.Struct(Credentials{Username: "joey", Password: "hunter2", Tokens: []string{"abc"}, Bio: long})
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 5)
+	Equal(t, ve[0].ValueString(), "joey")
+	Equal(t, ve[1].ValueString(), "[REDACTED]")
+	Equal(t, ve[1].Value(), "hunter2")
+	Equal(t, ve[2].Namespace(), "Credentials.Tokens[0]")
+	Equal(t, ve[2].ValueString(), "[REDACTED]")
+	Equal(t, ve[3].ValueString(), strings.Repeat("é", 64)+"...")
+	Equal(t, ve[4].ValueString(), "<nil>")
+
+	// without a sensitive tag nothing is redacted
+	ve = New().Struct(Credentials{Password: "hunter2"}).(ValidationErrors)
+	Equal(t, ve[1].ValueString(), "hunter2")
+
+	ve = validate.Var(5, "gt=10").(ValidationErrors)
+	Equal(t, ve[0].ValueString(), "5")
+}
+
+func TestStructWithRules(t *testing.T) {
+	type Item struct {
+		Name  string `validate:"required"`
+		Price int
+	}
+
+	type Address struct {
+		City string
+		Zip  string `validate:"len=5"`
+	}
+
+	type Order struct {
+		ID      int `validate:"gt=0"`
+		Note    string
+		Address *Address
+		Items   []Item
+		Lookup  map[string]Item
+	}
+
+	rules := map[string]string{
+		"Note":         "max=5",
+		"Address.City": "required",
+		"Address.Zip":  "-",
+		"Items":        "min=1,dive",
+		"Items.Price":  "gt=0",
+		"Lookup":       "dive",
+		"Lookup.Name":  "",
+	}
+
+	validate := New()
+
+	o := Order{
+		ID:      1,
+		Note:    "short",
+		Address: &Address{City: "Paris", Zip: "1"},
+		Items:   []Item{{Name: "a", Price: 1}},
+		Lookup:  map[string]Item{"x": {}},
+	}
+
+	Equal(t, validate.StructWithRules(o, rules), nil)
+
+	// struct tags still apply without the rules
+	errs := validate.Struct(o)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Order.Address.Zip", "Order.Address.Zip", "Zip", "Zip", "len")
+
+	o.ID = 0
+	o.Note = "too long"
+	o.Address.City = ""
+	o.Items = append(o.Items, Item{Price: 0})
+
+	errs = validate.StructWithRules(&o, rules)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 5)
+	AssertError(t, errs, "Order.ID", "Order.ID", "ID", "ID", "gt")
+	AssertError(t, errs, "Order.Note", "Order.Note", "Note", "Note", "max")
+	AssertError(t, errs, "Order.Address.City", "Order.Address.City", "City", "City", "required")
+	AssertError(t, errs, "Order.Items[1].Name", "Order.Items[1].Name", "Name", "Name", "required")
+	AssertError(t, errs, "Order.Items[1].Price", "Order.Items[1].Price", "Price", "Price", "gt")
+
+	errs = validate.StructWithRules(Order{ID: 1}, rules)
+	AssertError(t, errs, "Order.Items", "Order.Items", "Items", "Items", "min")
+
+	// rules don't persist to later validations
+	Equal(t, validate.Struct(Order{ID: 1}), nil)
+
+	PanicMatches(t, func() { _ = validate.StructWithRules(o, map[string]string{"Items.Missing": "required"}) }, "Field path 'Items.Missing' not found on struct validator.Order")
+	PanicMatches(t, func() { _ = validate.StructWithRules(o, map[string]string{"ID.Value": "required"}) }, "Field path 'ID.Value' not found on struct validator.Order")
+
+	_, ok := validate.StructWithRules(1, rules).(*InvalidValidationError)
+	Equal(t, ok, true)
+}
+
+func TestRegisterValidationSkipOnEmpty(t *testing.T) {
+	type Profile struct {
+		Handle   string            `validate:"handle"`
+		Age      int               `validate:"handle"`
+		Website  *string           `validate:"handle"`
+		Tags     []string          `validate:"handle"`
+		Extra    map[string]string `validate:"handle"`
+		Required string            `validate:"required,handle"`
+	}
+
+	var calls int
+
+	validate := New()
+	err := validate.RegisterValidationSkipOnEmpty("handle", func(fl FieldLevel) bool {
+		calls++
+		return fl.Field().Kind() == reflect.String && strings.HasPrefix(fl.Field().String(), "@")
+	})
+	Equal(t, err, nil)
+
+	errs := validate.Struct(Profile{Required: "@joey"})
+	Equal(t, errs, nil)
+	Equal(t, calls, 1)
+
+	site := "site"
+	errs = validate.Struct(Profile{Handle: "joey", Website: &site, Required: "@joey"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "Profile.Handle", "Profile.Handle", "Handle", "Handle", "handle")
+	AssertError(t, errs, "Profile.Website", "Profile.Website", "Website", "Website", "handle")
+	Equal(t, calls, 4)
+
+	// required still rejects the empty value before the validation is reached
+	errs = validate.Struct(Profile{})
+	AssertError(t, errs, "Profile.Required", "Profile.Required", "Required", "Required", "required")
+
+	Equal(t, validate.Var("", "handle"), nil)
+	NotEqual(t, validate.Var("joey", "handle"), nil)
+
+	err = validate.RegisterValidationSkipOnEmpty("", func(fl FieldLevel) bool { return true })
+	NotEqual(t, err, nil)
+
+	err = validate.RegisterValidationSkipOnEmpty("handle", nil)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "Function cannot be empty")
+}
+
+func TestVarErrorsStructErrors(t *testing.T) {
+	type User struct {
+		Name string `validate:"required"`
+	}
+
+	validate := New()
+
+	errs, err := validate.VarErrors("", "required")
+	Equal(t, err, nil)
+	Equal(t, len(errs), 1)
+	Equal(t, errs[0].Tag(), "required")
+
+	errs, err = validate.VarErrorsCtx(context.Background(), "value", "required")
+	Equal(t, err, nil)
+	Equal(t, errs == nil, true)
+
+	errs, err = validate.StructErrors(User{})
+	Equal(t, err, nil)
+	AssertError(t, errs, "User.Name", "User.Name", "Name", "Name", "required")
+
+	errs, err = validate.StructErrorsCtx(context.Background(), &User{Name: "joey"})
+	Equal(t, err, nil)
+	Equal(t, errs == nil, true)
+
+	errs, err = validate.StructErrors(1)
+	Equal(t, errs == nil, true)
+	Equal(t, IsInvalidValidationError(err), true)
+}
+
+func TestEmbeddedStructValidation(t *testing.T) {
+	type ValueEmbed struct {
+		BaseModel
+		Name string `validate:"required"`
+	}
+
+	type PointerEmbed struct {
+		*BaseModel
+		*Audit
+		Name string `validate:"required"`
+	}
+
+	var structLevelCalls int
+
+	validate := New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		structLevelCalls++
+		base := sl.Current().Interface().(BaseModel)
+		if base.ID > 100 {
+			sl.ReportError(base.ID, "ID", "ID", "maxid", "")
+		}
+	}, BaseModel{})
+
+	errs := validate.Struct(ValueEmbed{Name: "value"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	// promoted fields are namespaced by the embedded type's name
+	AssertError(t, errs, "ValueEmbed.BaseModel.ID", "ValueEmbed.BaseModel.ID", "ID", "ID", "gt")
+	AssertError(t, errs, "ValueEmbed.BaseModel.CreatedAt", "ValueEmbed.BaseModel.CreatedAt", "CreatedAt", "CreatedAt", "required")
+	Equal(t, structLevelCalls, 1)
+
+	errs = validate.Struct(&ValueEmbed{BaseModel: BaseModel{ID: 101, CreatedAt: time.Now()}, Name: "value"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "ValueEmbed.BaseModel.ID", "ValueEmbed.BaseModel.ID", "ID", "ID", "maxid")
+	Equal(t, structLevelCalls, 2)
+
+	// nil embedded pointers are skipped
+	Equal(t, validate.Struct(PointerEmbed{Name: "pointer"}), nil)
+	Equal(t, structLevelCalls, 2)
+
+	errs = validate.Struct(PointerEmbed{BaseModel: &BaseModel{ID: 101}, Audit: &Audit{}, Name: "pointer"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "PointerEmbed.BaseModel.CreatedAt", "PointerEmbed.BaseModel.CreatedAt", "CreatedAt", "CreatedAt", "required")
+	AssertError(t, errs, "PointerEmbed.BaseModel.ID", "PointerEmbed.BaseModel.ID", "ID", "ID", "maxid")
+	AssertError(t, errs, "PointerEmbed.Audit.By", "PointerEmbed.Audit.By", "By", "By", "required")
+	Equal(t, structLevelCalls, 3)
+
+	// the fields of embedded unexported types are validated too, however their values can't be
+	// obtained using Interface() within a struct level func as they aren't exported
+	type unexportedAudit Audit
+
+	type UnexportedEmbed struct {
+		unexportedAudit
+	}
+
+	errs = validate.Struct(UnexportedEmbed{})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "UnexportedEmbed.unexportedAudit.By", "UnexportedEmbed.unexportedAudit.By", "By", "By", "required")
+}
+
+func TestPasswordValidation(t *testing.T) {
+	tests := []struct {
+		value    string
+		tag      string
+		expected bool
+		param    string
+	}{
+		{"Passw0rd", "password", true, ""},
+		{"Pa0", "password", false, "min:8"},
+		{"password0", "password", false, "upper:1"},
+		{"PASSWORD0", "password", false, "lower:1"},
+		{"Password", "password", false, "digit:1"},
+		{"Passw0rd", "password=min:8;special:1", false, "special:1"},
+		{"Passw0rd!", "password=min:8;special:1", true, ""},
+		{"Pässwörd€", "password=min:9;upper:1;lower:7;special:1", true, ""},
+		{"ab", "password=min:3;upper:0", false, "min:3"},
+		{"aB1!aB1!aB", "password=min:10;upper:4", false, "upper:4"},
+		{"aB1!aB1!aB", "password=digit:2;special:2", true, ""},
+		{"aB1!aB1!aB", "password=min:8;upper:1,max=10", true, ""},
+		{"aB1!aB1!aB1", "password=min:8;upper:1,max=10", false, ""},
+		{"", "password=min:0", true, ""},
+	}
+
+	validate := New()
+
+	for i, test := range tests {
+
+		errs := validate.Var(test.value, test.tag)
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d password failed Error: %s", i, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d password failed Error: %s", i, errs)
+			}
+			if test.param != "" {
+				fe := errs.(ValidationErrors)[0]
+				Equal(t, fe.Tag(), "password")
+				Equal(t, fe.Param(), test.param)
+			}
+		}
+	}
+
+	type Registration struct {
+		Password string `validate:"required,password=min:12;special:1,max=64"`
+	}
+
+	errs := validate.Struct(Registration{Password: "Short1!"})
+	AssertError(t, errs, "Registration.Password", "Registration.Password", "Password", "Password", "password")
+	Equal(t, errs.(ValidationErrors)[0].Param(), "min:12")
+
+	PanicMatches(t, func() { _ = validate.Var("Passw0rd", "password=symbols:1") }, "Bad password requirement 'symbols:1'")
+	PanicMatches(t, func() { _ = validate.Var("Passw0rd", "password=min") }, "Bad password requirement 'min'")
+	PanicMatches(t, func() { _ = validate.Var("Passw0rd", "password=min:8 upper:1") }, "Bad password requirement 'min:8 upper:1'")
+	PanicMatches(t, func() { _ = validate.Var(1, "password") }, "Bad field type int")
+}
+
+func TestMapStructKeys(t *testing.T) {
+	type Key struct {
+		ID   int    `validate:"gt=0"`
+		Name string `validate:"required"`
+	}
+
+	type Value struct {
+		Count int `validate:"gte=1"`
+	}
+
+	type Inventory struct {
+		Items    map[Key]Value     `validate:"dive"`
+		Pointers map[*Key]*Value   `validate:"dive"`
+		Times    map[time.Time]int `validate:"dive,gt=0"`
+		Keyed    map[Key]string    `validate:"dive,keys,required,endkeys,required"`
+	}
+
+	validate := New()
+
+	good := Key{ID: 1, Name: "a"}
+	bad := Key{ID: 0, Name: "b"}
+
+	inv := Inventory{
+		Items:    map[Key]Value{good: {Count: 1}, bad: {Count: 0}},
+		Pointers: map[*Key]*Value{&bad: {Count: 1}},
+		Times:    map[time.Time]int{{}: 1},
+		Keyed:    map[Key]string{good: "x"},
+	}
+
+	errs := validate.Struct(inv)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "Inventory.Items[key:{0 b}].ID", "Inventory.Items[key:{0 b}].ID", "ID", "ID", "gt")
+	AssertError(t, errs, "Inventory.Items[{0 b}].Count", "Inventory.Items[{0 b}].Count", "Count", "Count", "gte")
+	Equal(t, errs.(ValidationErrors)[2].Namespace()[:len("Inventory.Pointers[key:")], "Inventory.Pointers[key:")
+	Equal(t, errs.(ValidationErrors)[2].Field(), "ID")
+	Equal(t, errs.(ValidationErrors)[0].PathSegments(), []string{"Inventory", "Items", "key:{0 b}", "ID"})
+
+	inv.Items = map[Key]Value{good: {Count: 1}}
+	inv.Pointers = nil
+	Equal(t, validate.Struct(inv), nil)
+}
+
+func TestFilePathValidation(t *testing.T) {
+	tests := []struct {
+		path    string
+		unix    bool
+		windows bool
+	}{
+		{"", false, false},
+		{"/usr/local/bin", true, true},
+		{"relative/path.txt", true, true},
+		{"../up", true, true},
+		{"dir/", true, true},
+		{"with space/and-dash_underscore", true, true},
+		{"nul\x00byte", false, false},
+		{"\xff\xfe", false, false},
+		{`C:\Users\joeybloggs\file.txt`, true, true},
+		{`\\server\share\file.txt`, true, true},
+		{`C:\bad:colon`, true, false},
+		{`dir\what?.txt`, true, false},
+		{`dir/<file>`, true, false},
+		{"tab\tname", true, false},
+		{`file|pipe`, true, false},
+	}
+
+	for i, test := range tests {
+		if isPathSyntax(test.path, false) != test.unix {
+			t.Fatalf("Index: %d filepath %q failed for unix", i, test.path)
+		}
+		if isPathSyntax(test.path, true) != test.windows {
+			t.Fatalf("Index: %d filepath %q failed for windows", i, test.path)
+		}
+	}
+
+	validate := New()
+
+	Equal(t, validate.Var("/does/not/need/to/exist.txt", "filepath"), nil)
+	NotEqual(t, validate.Var("", "filepath"), nil)
+	Equal(t, validate.Var("", "omitempty,filepath"), nil)
+
+	errs := validate.Var("nul\x00byte", "filepath")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "filepath")
+
+	PanicMatches(t, func() { _ = validate.Var(1, "filepath") }, "Bad field type int")
+}
+
+func TestURIPathValidation(t *testing.T) {
+	tests := []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"/", true},
+		{"/users/123", true},
+		{"users/123/", true},
+		{"/a/b;param=1/c:d@e", true},
+		{"/path%20with%2Fescapes", true},
+		{"/~user/file.name-_", true},
+		{"/bad%2", false},
+		{"/bad%zz", false},
+		{"/with space", false},
+		{"/query?x=1", false},
+		{"/frag#top", false},
+		{"/unicodé", false},
+		{"/back\\slash", false},
+	}
+
+	validate := New()
+
+	for i, test := range tests {
+
+		errs := validate.Var(test.param, "uri_path")
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d uri_path failed Error: %s", i, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d uri_path failed Error: %s", i, errs)
+			} else {
+				val := getError(errs, "", "")
+				if val.Tag() != "uri_path" {
+					t.Fatalf("Index: %d uri_path failed Error: %s", i, errs)
+				}
+			}
+		}
+	}
+
+	PanicMatches(t, func() { _ = validate.Var(1, "uri_path") }, "Bad field type int")
+}
+
+func TestInvalidValidationErrorDetails(t *testing.T) {
+	type User struct {
+		Name string `validate:"required"`
+	}
+
+	validate := New()
+
+	err := validate.Struct(5)
+	Equal(t, IsInvalidValidationError(err), true)
+
+	ive := err.(*InvalidValidationError)
+	Equal(t, ive.Kind, reflect.Int)
+	Equal(t, ive.Type == reflect.TypeOf(5), true)
+
+	var nilUser *User
+	err = validate.StructPartial(nilUser, "Name")
+	Equal(t, IsInvalidValidationError(err), true)
+	Equal(t, err.(*InvalidValidationError).Kind, reflect.Ptr)
+	Equal(t, err.Error(), "validator: (nil *validator.User)")
+
+	s := []User{}
+	err = validate.StructExcept(&s)
+	Equal(t, err.(*InvalidValidationError).Kind, reflect.Slice)
+	Equal(t, err.Error(), "validator: expected a struct but got *[]validator.User of kind slice, use Var to validate a single variable")
+
+	err = validate.Struct(nil)
+	Equal(t, IsInvalidValidationError(err), true)
+	Equal(t, err.(*InvalidValidationError).Kind, reflect.Invalid)
+
+	Equal(t, IsInvalidValidationError(fmt.Errorf("wrapped: %w", err)), true)
+	Equal(t, IsInvalidValidationError(validate.Struct(User{})), false)
+	Equal(t, IsInvalidValidationError(nil), false)
+}
+
+func TestStructAll(t *testing.T) {
+	type User struct {
+		Name  string `validate:"required"`
+		Email string `validate:"required,email"`
+	}
+
+	type Order struct {
+		ID    int    `validate:"gt=0"`
+		Users []User `validate:"dive"`
+	}
+
+	validate := New()
+
+	Equal(t, validate.StructAll(), nil)
+	Equal(t, validate.StructAll(User{Name: "a", Email: "a@example.com"}, &Order{ID: 1}), nil)
+
+	err := validate.StructAll(
+		User{Name: "a", Email: "a@example.com"},
+		&User{Email: "bad"},
+		Order{Users: []User{{Name: "c"}}},
+		struct {
+			Val int `validate:"required"`
+		}{},
+	)
+	NotEqual(t, err, nil)
+
+	errs := err.(ValidationErrors)
+	Equal(t, len(errs), 5)
+	AssertError(t, errs, "User[1].Name", "User[1].Name", "Name", "Name", "required")
+	AssertError(t, errs, "User[1].Email", "User[1].Email", "Email", "Email", "email")
+	AssertError(t, errs, "Order[2].ID", "Order[2].ID", "ID", "ID", "gt")
+	AssertError(t, errs, "Order[2].Users[0].Email", "Order[2].Users[0].Email", "Email", "Email", "required")
+	AssertError(t, errs, "[3].Val", "[3].Val", "Val", "Val", "required")
+
+	// the namespace remains relative to the struct
+	Equal(t, errs[3].PathSegments(), []string{"Order", "2", "Users", "0", "Email"})
+	_, ok := errs.Tree()["Users"]
+	Equal(t, ok, true)
+
+	err = validate.StructAll(User{}, 1)
+	NotEqual(t, err, nil)
+	_, ok = err.(*InvalidValidationError)
+	Equal(t, ok, true)
+}
+
+func TestFieldLevelFieldRaw(t *testing.T) {
+	type Test struct {
+		Ptr    *string        `validate:"fieldraw"`
+		Custom sql.NullString `validate:"fieldraw"`
+		Iface  interface{}    `validate:"fieldraw"`
+		Nil    *string        `validate:"omitempty,fieldraw"`
+		Slice  []*string      `validate:"dive,fieldraw"`
+	}
+
+	var kinds, rawKinds []reflect.Kind
+
+	validate := New()
+	validate.RegisterCustomTypeFunc(ValidateValuerType, sql.NullString{})
+	err := validate.RegisterValidation("fieldraw", func(fl FieldLevel) bool {
+		kinds = append(kinds, fl.Field().Kind())
+		rawKinds = append(rawKinds, fl.FieldRaw().Kind())
+		return fl.Field().String() == "ok"
+	})
+	Equal(t, err, nil)
+
+	ok := "ok"
+	tst := Test{Ptr: &ok, Custom: sql.NullString{String: "ok", Valid: true}, Iface: &ok, Slice: []*string{&ok}}
+
+	Equal(t, validate.Struct(tst), nil)
+	Equal(t, kinds, []reflect.Kind{reflect.String, reflect.String, reflect.String, reflect.String})
+	Equal(t, rawKinds, []reflect.Kind{reflect.Ptr, reflect.Struct, reflect.Interface, reflect.Ptr})
+
+	kinds, rawKinds = nil, nil
+
+	errs := validate.Var(&ok, "fieldraw")
+	Equal(t, errs, nil)
+	Equal(t, kinds, []reflect.Kind{reflect.String})
+	Equal(t, rawKinds, []reflect.Kind{reflect.Ptr})
+}
+
+func TestFieldLevelKindAndType(t *testing.T) {
+	type Test struct {
+		Ptr    *string        `validate:"kindtype"`
+		Custom sql.NullString `validate:"kindtype"`
+		Iface  interface{}    `validate:"kindtype"`
+		Nil    *int           `validate:"kindtype"`
+		Count  uint8          `validate:"kindtype"`
+	}
+
+	var kinds []reflect.Kind
+	var types []reflect.Type
+
+	validate := New()
+	validate.RegisterCustomTypeFunc(ValidateValuerType, sql.NullString{})
+	err := validate.RegisterValidation("kindtype", func(fl FieldLevel) bool {
+		kinds = append(kinds, fl.Kind())
+		types = append(types, fl.Type())
+		return true
+	}, true)
+	Equal(t, err, nil)
+
+	ok := "ok"
+	errs := validate.Struct(Test{Ptr: &ok, Custom: sql.NullString{String: "ok", Valid: true}, Iface: 1.5})
+	Equal(t, errs, nil)
+	Equal(t, kinds, []reflect.Kind{reflect.String, reflect.String, reflect.Float64, reflect.Ptr, reflect.Uint8})
+	Equal(t, types, []reflect.Type{reflect.TypeOf(""), reflect.TypeOf(""), reflect.TypeOf(1.5), reflect.TypeOf((*int)(nil)), reflect.TypeOf(uint8(0))})
+
+	kinds, types = nil, nil
+
+	errs = validate.Struct(Test{Custom: sql.NullString{Valid: true}})
+	Equal(t, errs, nil)
+	Equal(t, kinds[2], reflect.Interface)
+	Equal(t, types[2] == reflect.TypeOf((*interface{})(nil)).Elem(), true)
+}
+
+func TestRegisterStructValidationMapRules(t *testing.T) {
+	type Inner struct {
+		Code string `validate:"len=3"`
+	}
+
+	type Account struct {
+		Inner
+		Username        string `validate:"required"`
+		Password        string
+		ConfirmPassword string `validate:"required"`
+		Nickname        string `json:"nick"`
+	}
+
+	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return fld.Tag.Get("json")
+	})
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"Password":        "required,min=8",
+		"ConfirmPassword": "eqfield=Password",
+		"Nickname":        "omitempty,alpha",
+	}, &Account{})
+
+	a := Account{Inner: Inner{Code: "abc"}, Username: "joeybloggs", Password: "secret123", ConfirmPassword: "secret123"}
+	Equal(t, validate.Struct(a), nil)
+
+	a.Username = ""
+	a.Password = "short"
+	a.Nickname = "n1ck"
+
+	errs := validate.Struct(a)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 4)
+	// struct tags of fields without a rule still apply
+	AssertError(t, errs, "Account.Username", "Account.Username", "Username", "Username", "required")
+	AssertError(t, errs, "Account.Password", "Account.Password", "Password", "Password", "min")
+	// the rule replaces the struct tag
+	AssertError(t, errs, "Account.ConfirmPassword", "Account.ConfirmPassword", "ConfirmPassword", "ConfirmPassword", "eqfield")
+	AssertError(t, errs, "Account.nick", "Account.Nickname", "nick", "Nickname", "alpha")
+
+	a.ConfirmPassword = ""
+	errs = validate.Struct(a)
+	AssertError(t, errs, "Account.ConfirmPassword", "Account.ConfirmPassword", "ConfirmPassword", "ConfirmPassword", "eqfield")
+
+	// other instances aren't affected
+	a = Account{Inner: Inner{Code: "abc"}, Username: "joeybloggs", ConfirmPassword: "x"}
+	Equal(t, New().Struct(a), nil)
+
+	PanicMatches(t, func() {
+		validate.RegisterStructValidationMapRules(map[string]string{"Missing": "required"}, Account{})
+	}, "Field 'Missing' not found on struct validator.Account")
+	PanicMatches(t, func() {
+		validate.RegisterStructValidationMapRules(map[string]string{"Code": "required"}, Account{})
+	}, "Field 'Code' not found on struct validator.Account")
+	PanicMatches(t, func() {
+		validate.RegisterStructValidationMapRules(map[string]string{}, 1)
+	}, "RegisterStructValidationMapRules requires a struct, got int")
+}
+
+func TestBytesAsString(t *testing.T) {
+	type Contact struct {
+		Email []byte `validate:"required,email,max=20"`
+		Name  []byte `validate:"omitempty,alpha,len=4"`
+		Tag   []byte `validate:"oneof=a b"`
+	}
+
+	validate := New()
+
+	c := Contact{Email: []byte("test@example.com"), Name: []byte("café"), Tag: []byte("a")}
+
+	errs := validate.Struct(c)
+	NotEqual(t, errs, nil)
+	// alpha is ASCII only, validated against the string contents
+	AssertError(t, errs, "Contact.Name", "Contact.Name", "Name", "Name", "alpha")
+
+	c.Name = []byte("joey")
+	Equal(t, validate.Struct(c), nil)
+
+	c.Email = []byte("not an email")
+	c.Tag = []byte("c")
+	errs = validate.Struct(c)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "Contact.Email", "Contact.Email", "Email", "Email", "email")
+	AssertError(t, errs, "Contact.Tag", "Contact.Tag", "Tag", "Tag", "oneof")
+	Equal(t, errs.(ValidationErrors)[0].Value(), []byte("not an email"))
+
+	c.Email = []byte("averylongemail@example.com")
+	c.Tag = []byte("b")
+	errs = validate.Struct(c)
+	AssertError(t, errs, "Contact.Email", "Contact.Email", "Email", "Email", "max")
+
+	Equal(t, validate.Var([]byte("é"), "len=2"), nil)
+	Equal(t, validate.Var("é", "len=1"), nil)
+	Equal(t, validate.Var([]byte("123"), "numeric,startswith=1"), nil)
+	NotEqual(t, validate.Var([]byte("12a"), "numeric"), nil)
+	Equal(t, validate.Var([]byte{}, "required"), nil)
+	NotEqual(t, validate.Var([]byte(nil), "required"), nil)
+
+	// the value reported remains the []byte, whichever validation fails
+	errs = validate.Var([]byte("a b"), "alpha|email")
+	NotEqual(t, errs, nil)
+	Equal(t, errs.(ValidationErrors)[0].Value(), []byte("a b"))
+
+	errs = validate.Var([]byte("abc"), "alpha,len=2")
+	NotEqual(t, errs, nil)
+	Equal(t, errs.(ValidationErrors)[0].Tag(), "len")
+	Equal(t, errs.(ValidationErrors)[0].Value(), []byte("abc"))
+}
+
+type opaqueMoney struct {
+	Currency string `validate:"len=3"`
+	Amount   int64
+}
+
+func TestRegisterOpaqueType(t *testing.T) {
+	type Test struct {
+		When    time.Time    `validate:"required"`
+		Link    url.URL      `validate:"required"`
+		Price   opaqueMoney  `validate:"required"`
+		Tip     *opaqueMoney `validate:"omitempty"`
+		Balance *big.Int     `validate:"required"`
+	}
+
+	tst := Test{
+		When:    time.Now(),
+		Link:    url.URL{Scheme: "https", Host: "example.com"},
+		Price:   opaqueMoney{Currency: "dollars", Amount: 1},
+		Tip:     &opaqueMoney{},
+		Balance: big.NewInt(0),
+	}
+
+	validate := New()
+
+	// by default structs are recursed into
+	errs := validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Test.Price.Currency", "Test.Price.Currency", "Currency", "Currency", "len")
+	AssertError(t, errs, "Test.Tip.Currency", "Test.Tip.Currency", "Currency", "Currency", "len")
+
+	// only time.Time is validated as a value by default, the tags of url.URL are ignored
+	errs = validate.Struct(Test{Price: opaqueMoney{Currency: "USD"}, Balance: tst.Balance})
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Test.When", "Test.When", "When", "When", "required")
+
+	// unless the standard library types are opted in to
+	validate = New(WithStdlibOpaqueTypes())
+
+	errs = validate.Struct(Test{Price: opaqueMoney{Currency: "USD"}, Balance: tst.Balance})
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Test.When", "Test.When", "When", "When", "required")
+	AssertError(t, errs, "Test.Link", "Test.Link", "Link", "Link", "required")
+
+	// notblank compares incomparable opaque types without panicking
+	Equal(t, validate.Var(regexp.MustCompile("a"), "notblank"), nil)
+	NotEqual(t, validate.Var(regexp.Regexp{}, "notblank"), nil)
+
+	validate.RegisterOpaqueType(&opaqueMoney{})
+
+	errs = validate.Struct(tst)
+	Equal(t, errs, nil)
+
+	tst.Price = opaqueMoney{}
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Test.Price", "Test.Price", "Price", "Price", "required")
+
+	// opaque types aren't checked for missing tags either
+	type Tagged struct {
+		When  time.Time   `validate:"-"`
+		Price opaqueMoney `validate:"required"`
+	}
+
+	validate.SetRequireAllFieldsHaveTags(true)
+	errs = validate.Struct(Tagged{Price: opaqueMoney{Currency: "USD"}})
+	Equal(t, errs, nil)
+
+	// opaque types are validated using Var, as time.Time is, not Struct
+	err := validate.Struct(&opaqueMoney{Currency: "USD"})
+	NotEqual(t, err, nil)
+	Equal(t, IsInvalidValidationError(err), true)
+	Equal(t, err.Error(), "validator: *validator.opaqueMoney is validated as a value rather than by its fields, use Var to validate a single variable")
+
+	err = validate.Struct(time.Time{})
+	Equal(t, err.Error(), "validator: time.Time is validated as a value rather than by its fields, use Var to validate a single variable")
+
+	err = validate.Struct(1)
+	Equal(t, err.Error(), "validator: expected a struct but got int of kind int, use Var to validate a single variable")
+}
+
+type zeroDecimal struct {
+	unscaled int64
+	scale    int32
+}
+
+func TestRegisterZeroFunc(t *testing.T) {
+	type Test struct {
+		Amount   zeroDecimal  `validate:"required"`
+		Discount zeroDecimal  `validate:"omitempty,decimalpositive"`
+		Tip      *zeroDecimal `validate:"required"`
+		Count    int          `validate:"required"`
+	}
+
+	zero := zeroDecimal{unscaled: 0, scale: 2}  // 0.00
+	one := zeroDecimal{unscaled: 100, scale: 2} // 1.00
+	neg := zeroDecimal{unscaled: -100, scale: 2}
+
+	validate := New()
+	err := validate.RegisterValidation("decimalpositive", func(fl FieldLevel) bool {
+		return fl.Field().Interface().(zeroDecimal).unscaled > 0
+	})
+	Equal(t, err, nil)
+
+	// by default the non zero bits 0.00 has a value and the struct isn't validated as a value
+	errs := validate.Struct(Test{Amount: zero, Discount: neg, Tip: &zero, Count: 1})
+	Equal(t, errs, nil)
+
+	validate.RegisterZeroFunc(zeroDecimal{}, func(field reflect.Value) bool {
+		return field.Interface().(zeroDecimal).unscaled == 0
+	})
+
+	errs = validate.Struct(Test{Amount: zero, Discount: zero, Tip: &zero, Count: 1})
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Test.Amount", "Test.Amount", "Amount", "Amount", "required")
+
+	errs = validate.Struct(Test{Amount: one, Discount: neg, Count: 1})
+	NotEqual(t, errs, nil)
+
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Test.Discount", "Test.Discount", "Discount", "Discount", "decimalpositive")
+	AssertError(t, errs, "Test.Tip", "Test.Tip", "Tip", "Tip", "required")
+
+	errs = validate.Struct(Test{Amount: one, Discount: one, Tip: &one, Count: 1})
+	Equal(t, errs, nil)
+
+	errs = validate.Var(zero, "required")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "required")
+
+	errs = validate.Var(one, "required")
+	Equal(t, errs, nil)
+
+	errs = validate.Var(nil, "omitempty")
+	Equal(t, errs, nil)
+
+	// pointer samples register the type pointed to
+	validate = New()
+	validate.RegisterZeroFunc(&zeroDecimal{}, func(field reflect.Value) bool {
+		return field.Interface().(zeroDecimal).unscaled == 0
+	})
+
+	errs = validate.Var(zero, "required")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "required")
+
+	errs = validate.Var(one, "required")
+	Equal(t, errs, nil)
+}
+
+type iterNode struct {
+	Value string
+	Next  *iterNode
+}
+
+type iterList struct {
+	Head *iterNode
+	Len  int
+}
+
+func TestRegisterIterator(t *testing.T) {
+	validate := New()
+	validate.RegisterIterator(&iterList{}, func(list reflect.Value) func() (reflect.Value, bool) {
+		n := list.Interface().(iterList).Head
+		return func() (reflect.Value, bool) {
+			if n == nil {
+				return reflect.Value{}, false
+			}
+			v := n.Value
+			n = n.Next
+			return reflect.ValueOf(v), true
+		}
+	})
+
+	type Test struct {
+		Names    iterList  `validate:"dive,required,alpha"`
+		PtrNames *iterList `validate:"required,dive,alpha"`
 	}
 
-	errs = validate.Struct(test2)
+	list := iterList{Head: &iterNode{Value: "a", Next: &iterNode{Value: "b"}}, Len: 2}
+	errs := validate.Struct(Test{Names: list, PtrNames: &list})
+	Equal(t, errs, nil)
+
+	bad := iterList{Head: &iterNode{Value: "a", Next: &iterNode{Value: "", Next: &iterNode{Value: "c3"}}}}
+	errs = validate.Struct(Test{Names: bad, PtrNames: &bad})
 	NotEqual(t, errs, nil)
 
 	ve := errs.(ValidationErrors)
-	Equal(t, len(ve), 5)
-	for i := 1; i <= 5; i++ {
-		name := fmt.Sprintf("Field%d", i)
-		AssertError(t, errs, name, name, name, name, "excluded_with_all")
+	Equal(t, len(ve), 4)
+	AssertError(t, errs, "Test.Names[1]", "Test.Names[1]", "Names[1]", "Names[1]", "required")
+	AssertError(t, errs, "Test.Names[2]", "Test.Names[2]", "Names[2]", "Names[2]", "alpha")
+	AssertError(t, errs, "Test.PtrNames[1]", "Test.PtrNames[1]", "PtrNames[1]", "PtrNames[1]", "alpha")
+	AssertError(t, errs, "Test.PtrNames[2]", "Test.PtrNames[2]", "PtrNames[2]", "PtrNames[2]", "alpha")
+
+	errs = validate.Struct(Test{})
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Test.PtrNames", "Test.PtrNames", "PtrNames", "PtrNames", "required")
+
+	errs = validate.Var(bad, "dive,alpha")
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "[1]", "[1]", "[1]", "[1]", "alpha")
+
+	// unregistered structs are descended into rather than dived
+	errs = New().Var(bad, "dive,alpha")
+	Equal(t, errs, nil)
+}
+
+func TestAgeValidation(t *testing.T) {
+	var now time.Time
+
+	validate := New(WithNowFunc(func() time.Time { return now }))
+
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
 	}
 
-	test3 := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_with_all=FieldE Field" json:"field_1"`
-		Field2 *string           `validate:"excluded_with_all=FieldE Field" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_with_all=FieldE Field" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_with_all=FieldE Field" json:"field_4"`
-		Field5 string            `validate:"excluded_with_all=Inner.FieldE" json:"field_5"`
-		Field6 string            `validate:"excluded_with_all=Inner2.FieldE" json:"field_6"`
+	tests := []struct {
+		now      time.Time
+		birth    time.Time
+		tag      string
+		expected bool
 	}{
-		Inner:  &Inner{FieldE: "populated"},
-		Inner2: &Inner{FieldE: "populated"},
-		Field:  "populated",
-		FieldE: "populated",
+		// exact boundary
+		{now: date(2024, 6, 15), birth: date(2006, 6, 15), tag: "agemin=18", expected: true},
+		{now: date(2024, 6, 14), birth: date(2006, 6, 15), tag: "agemin=18", expected: false},
+		{now: date(2024, 5, 20), birth: date(2006, 6, 15), tag: "agemin=18", expected: false},
+		{now: date(2024, 7, 1), birth: date(2006, 6, 15), tag: "agemin=18", expected: true},
+		{now: date(2024, 6, 14), birth: date(1904, 6, 15), tag: "agemax=120", expected: true},
+		{now: date(2024, 6, 15), birth: date(1904, 6, 15), tag: "agemax=119", expected: false},
+		{now: date(2024, 6, 15), birth: date(2006, 6, 15), tag: "agemin=18,agemax=18", expected: true},
+		// leap day birthdays come of age on the 1st of March in non leap years
+		{now: date(2022, 2, 28), birth: date(2004, 2, 29), tag: "agemin=18", expected: false},
+		{now: date(2022, 3, 1), birth: date(2004, 2, 29), tag: "agemin=18", expected: true},
+		{now: date(2024, 2, 28), birth: date(2000, 2, 29), tag: "agemin=24", expected: false},
+		{now: date(2024, 2, 29), birth: date(2000, 2, 29), tag: "agemin=24", expected: true},
+		// zero is accepted
+		{now: date(2024, 1, 1), birth: time.Time{}, tag: "agemax=120", expected: true},
+		{now: date(2024, 1, 1), birth: time.Time{}, tag: "required,agemin=18", expected: false},
 	}
 
-	validate = New()
+	for i, test := range tests {
+		now = test.now
+		errs := validate.Var(test.birth, test.tag)
+		if test.expected != (errs == nil) {
+			t.Fatalf("Index: %d age %s of %s at %s failed Error: %s", i, test.tag, test.birth, test.now, errs)
+		}
+	}
 
-	errs = validate.Struct(test3)
+	// age is computed as of the current time in the birthdate's location
+	loc := time.FixedZone("UTC+10", 10*60*60)
+	now = time.Date(2024, 6, 14, 20, 0, 0, 0, time.UTC)
+	errs := validate.Var(time.Date(2006, 6, 15, 0, 0, 0, 0, loc), "agemin=18")
 	Equal(t, errs, nil)
+
+	errs = validate.Var(date(2006, 6, 15), "agemin=18")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "agemin")
+
+	PanicMatches(t, func() { _ = validate.Var("2006-06-15", "agemin=18") }, "Bad field type string")
 }
 
-func TestExcludedWithoutAll(t *testing.T) {
-	type Inner struct {
-		FieldE string
-		Field  *string
+func TestSetNowFunc(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	validate := New()
+	validate.SetNowFunc(func() time.Time { return now })
+
+	for _, tag := range []string{"gt", "gte", "future"} {
+		Equal(t, validate.Var(now.Add(time.Second), tag), nil)
+		NotEqual(t, validate.Var(now.Add(-time.Second), tag), nil)
 	}
 
-	fieldVal := "test"
-	test := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_without_all=Field FieldE" json:"field_1"`
-		Field2 *string           `validate:"excluded_without_all=Field FieldE" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_without_all=Field FieldE" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_without_all=Field FieldE" json:"field_4"`
-		Field5 string            `validate:"excluded_without_all=Inner.Field Inner2.Field" json:"field_5"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Inner2: &Inner{Field: &fieldVal},
-		Field:  "populated",
-		Field1: fieldVal,
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
+	for _, tag := range []string{"lt", "lte", "past"} {
+		Equal(t, validate.Var(now.Add(-time.Second), tag), nil)
+		NotEqual(t, validate.Var(now.Add(time.Second), tag), nil)
 	}
 
-	validate := New()
+	Equal(t, validate.Var(now, "gte"), nil)
+	Equal(t, validate.Var(now, "lte"), nil)
 
-	errs := validate.Struct(test)
-	Equal(t, errs, nil)
+	// other instances keep using time.Now
+	NotEqual(t, New().Var(now.Add(time.Second), "gt"), nil)
 
-	test2 := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_without_all=FieldE Field" json:"field_1"`
-		Field2 *string           `validate:"excluded_without_all=FieldE Field" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_without_all=FieldE Field" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_without_all=FieldE Field" json:"field_4"`
-		Field5 string            `validate:"excluded_without_all=Inner.FieldE" json:"field_5"`
-		Field6 string            `validate:"excluded_without_all=Inner2.FieldE" json:"field_6"`
+	validate.SetNowFunc(nil)
+	NotEqual(t, validate.Var(now.Add(time.Second), "gt"), nil)
+
+	// the clock may be changed while validating concurrently
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = validate.Var(now, "future")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			validate.SetNowFunc(func() time.Time { return now })
+		}()
+	}
+	wg.Wait()
+}
+
+func TestFuturePastValidation(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	validate := New()
+	validate.SetNowFunc(func() time.Time { return now })
+
+	tests := []struct {
+		value    time.Time
+		tag      string
+		expected bool
 	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field1: fieldVal,
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
-		Field6: "test",
+		{now.Add(time.Second), "future", true},
+		{now, "future", false},
+		{now.Add(-time.Minute), "future", false},
+		{now.Add(-time.Minute), "future=5m", true},
+		{now.Add(-10 * time.Minute), "future=5m", false},
+		{now.Add(-time.Second), "past", true},
+		{now, "past", false},
+		{now.Add(time.Minute), "past=5m", true},
+		{now.Add(10 * time.Minute), "past=5m", false},
+		{now.Add(time.Hour), "gtnow", true},
+		{now.Add(time.Hour), "ltnow", false},
+		{time.Time{}, "future", true},
+		{time.Time{}, "past", true},
+		{time.Time{}, "required,future", false},
 	}
 
-	errs = validate.Struct(test2)
+	for i, test := range tests {
+		errs := validate.Var(test.value, test.tag)
+
+		if test.expected {
+			if !IsEqual(errs, nil) {
+				t.Fatalf("Index: %d %s failed Error: %s", i, test.tag, errs)
+			}
+		} else {
+			if IsEqual(errs, nil) {
+				t.Fatalf("Index: %d %s failed Error: %s", i, test.tag, errs)
+			}
+		}
+	}
+
+	type Event struct {
+		Start time.Time  `validate:"required,future"`
+		End   *time.Time `validate:"omitempty,gtnow"`
+	}
+
+	past := now.Add(-time.Hour)
+
+	errs := validate.Struct(Event{Start: now.Add(time.Hour), End: &past})
 	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Event.End", "Event.End", "End", "End", "gtnow")
 
-	ve := errs.(ValidationErrors)
-	Equal(t, len(ve), 6)
-	for i := 1; i <= 6; i++ {
-		name := fmt.Sprintf("Field%d", i)
-		AssertError(t, errs, name, name, name, name, "excluded_without_all")
+	PanicMatches(t, func() { _ = validate.Var(1, "future") }, "Bad field type int")
+	PanicMatches(t, func() { _ = validate.Var(now, "past=soon") }, "strconv.ParseInt: parsing \"soon\": invalid syntax")
+}
+
+func TestRequireAllFieldsHaveTags(t *testing.T) {
+	type Address struct {
+		Street string `validate:"required"`
+		Zip    string
 	}
 
-	test3 := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field  string            `validate:"omitempty" json:"field"`
-		FieldE string            `validate:"omitempty" json:"field_e"`
-		Field1 string            `validate:"excluded_without_all=Field FieldE" json:"field_1"`
-		Field2 *string           `validate:"excluded_without_all=Field FieldE" json:"field_2"`
-		Field3 map[string]string `validate:"excluded_without_all=Field FieldE" json:"field_3"`
-		Field4 interface{}       `validate:"excluded_without_all=Field FieldE" json:"field_4"`
-		Field5 string            `validate:"excluded_without_all=Inner.Field Inner2.Field" json:"field_5"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Inner2: &Inner{Field: &fieldVal},
-		Field:  "populated",
-		FieldE: "populated",
+	type User struct {
+		Name     string `validate:"required"`
+		Nickname string `validate:""`
+		Password string `validate:"-"`
+		Age      int
+		Address  *Address
+		Created  time.Time `validate:"required"`
+		internal string
+	}
+
+	u := User{Name: "name", Created: time.Now()}
+
+	validate := New()
+	Equal(t, validate.Struct(u), nil)
+
+	validate.SetRequireAllFieldsHaveTags(true)
+
+	err := validate.Struct(u)
+	NotEqual(t, err, nil)
+
+	mte, ok := err.(*MissingTagsError)
+	Equal(t, ok, true)
+	Equal(t, mte.Fields, []string{"User.Age", "User.Address", "User.Address.Zip"})
+	Equal(t, mte.Error(), "validator: fields missing a 'validate' tag: User.Age, User.Address, User.Address.Zip")
+
+	err = validate.StructPartial(&u, "Name")
+	_, ok = err.(*MissingTagsError)
+	Equal(t, ok, true)
+
+	// types from other packages aren't recursed into
+	type Wrapper struct {
+		Builder strings.Builder `validate:""`
 	}
 
+	Equal(t, validate.Struct(Wrapper{}), nil)
+
 	validate = New()
+	validate.RegisterRequireAllFieldsHaveTags(&Address{})
 
-	errs = validate.Struct(test3)
-	Equal(t, errs, nil)
+	err = validate.Struct(u)
+	NotEqual(t, err, nil)
+	Equal(t, err.(*MissingTagsError).Fields, []string{"User.Address.Zip"})
+
+	type Tagged struct {
+		Zip string `validate:"omitempty,numeric"`
+	}
+
+	validate.RegisterRequireAllFieldsHaveTags(Tagged{})
+	Equal(t, validate.Struct(Tagged{}), nil)
+
+	PanicMatches(t, func() { validate.RegisterRequireAllFieldsHaveTags("") }, "RegisterRequireAllFieldsHaveTags requires a struct, got string")
 }
 
-func TestRequiredWithAll(t *testing.T) {
-	type Inner struct {
-		Field *string
+func TestMethodValidation(t *testing.T) {
+	validate := New()
+
+	// value receiver on the struct and the field
+	o := methodOrder{Items: []int{1, 2}, Total: 3, Code: "abc"}
+	Equal(t, validate.Struct(o), nil)
+	Equal(t, validate.Struct(&o), nil)
+
+	code := methodCode("toolong")
+	o.Total = 4
+	o.Code = "ab"
+	o.Ptr = &code
+
+	errs := validate.Struct(o).(ValidationErrors)
+	Equal(t, len(errs), 3)
+	AssertError(t, errs, "methodOrder.Items", "methodOrder.Items", "Items", "Items", "method")
+	AssertError(t, errs, "methodOrder.Code", "methodOrder.Code", "Code", "Code", "method")
+	AssertError(t, errs, "methodOrder.Ptr", "methodOrder.Ptr", "Ptr", "Ptr", "method")
+	Equal(t, errs[0].Param(), "TotalValid")
+
+	// pointer receiver on the struct, whether or not it is addressable
+	r := methodRange{Min: 1, Max: 2}
+	Equal(t, validate.Struct(r), nil)
+	Equal(t, validate.Struct(&r), nil)
+
+	r.Min = 3
+	errs = validate.Struct(r).(ValidationErrors)
+	Equal(t, len(errs), 2)
+	AssertError(t, errs, "methodRange.Min", "methodRange.Min", "Min", "Min", "method")
+	AssertError(t, errs, "methodRange.Max", "methodRange.Max", "Max", "Max", "method")
+
+	errs = validate.Struct(&r).(ValidationErrors)
+	Equal(t, len(errs), 2)
+
+	Equal(t, validate.Var(methodCode("abc"), "method=Valid"), nil)
+	NotEqual(t, validate.Var(methodCode("ab"), "method=Valid"), nil)
+
+	PanicMatches(t, func() { _ = validate.Var(methodCode("abc"), "method=Missing") }, "Method 'Missing' not found on field  or its parent")
+	PanicMatches(t, func() { _ = validate.Struct(methodBadSignature{}) }, "Method 'Check' on validator.methodBadSignature must take no arguments and return a bool")
+}
+
+func TestFieldErrorPathSegments(t *testing.T) {
+	type Item struct {
+		Name string `json:"name" validate:"required"`
 	}
 
-	fieldVal := "test"
-	test := struct {
-		Inner   *Inner
-		FieldE  string            `validate:"omitempty" json:"field_e"`
-		FieldER string            `validate:"required_with_all=FieldE" json:"field_er"`
-		Field1  string            `validate:"omitempty" json:"field_1"`
-		Field2  *string           `validate:"required_with_all=Field1" json:"field_2"`
-		Field3  map[string]string `validate:"required_with_all=Field2" json:"field_3"`
-		Field4  interface{}       `validate:"required_with_all=Field3" json:"field_4"`
-		Field5  string            `validate:"required_with_all=Inner.Field" json:"field_5"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field1: "test_field1",
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
+	type Order struct {
+		Items  []Item            `json:"items" validate:"dive"`
+		Matrix [][]int           `json:"matrix" validate:"dive,dive,gt=0"`
+		Labels map[string]string `json:"labels" validate:"dive,required"`
 	}
 
 	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	})
 
-	errs := validate.Struct(test)
-	Equal(t, errs, nil)
+	o := Order{
+		Items:  []Item{{Name: "ok"}, {}},
+		Matrix: [][]int{{1}, {1, 0}},
+		Labels: map[string]string{"env": ""},
+	}
 
-	test2 := struct {
-		Inner   *Inner
-		Inner2  *Inner
-		FieldE  string            `validate:"omitempty" json:"field_e"`
-		FieldER string            `validate:"required_with_all=FieldE" json:"field_er"`
-		Field1  string            `validate:"omitempty" json:"field_1"`
-		Field2  *string           `validate:"required_with_all=Field1" json:"field_2"`
-		Field3  map[string]string `validate:"required_with_all=Field2" json:"field_3"`
-		Field4  interface{}       `validate:"required_with_all=Field1 FieldE" json:"field_4"`
-		Field5  string            `validate:"required_with_all=Inner.Field Field2" json:"field_5"`
-		Field6  string            `validate:"required_with_all=Inner2.Field Field2" json:"field_6"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field2: &fieldVal,
+	err := validate.Struct(o)
+	NotEqual(t, err, nil)
+
+	errs := err.(ValidationErrors)
+	Equal(t, len(errs), 3)
+	Equal(t, errs[0].PathSegments(), []string{"Order", "Items", "1", "Name"})
+	Equal(t, errs[1].PathSegments(), []string{"Order", "Matrix", "1", "1"})
+	Equal(t, errs[2].PathSegments(), []string{"Order", "Labels", "env"})
+
+	validate.SetNamespaceSeparator("/")
+	validate.SetNamespaceBrackets("<", ">")
+
+	errs = validate.Struct(o).(ValidationErrors)
+	Equal(t, errs[0].StructNamespace(), "Order/Items<1>/Name")
+	Equal(t, errs[0].PathSegments(), []string{"Order", "Items", "1", "Name"})
+
+	errs = validate.Var("", "required").(ValidationErrors)
+	Equal(t, len(errs[0].PathSegments()), 0)
+}
+
+func TestValidationErrorsGroupByParent(t *testing.T) {
+	type Address struct {
+		Street string `validate:"required"`
+		Zip    string `validate:"required,numeric"`
+	}
+
+	type User struct {
+		Name      string    `validate:"required"`
+		Address   Address   `validate:"required"`
+		Addresses []Address `validate:"dive"`
+		Tags      []string  `validate:"dive,alpha"`
+	}
+
+	validate := New()
+
+	u := User{
+		Address:   Address{Street: "1 Main St", Zip: "abc"},
+		Addresses: []Address{{Street: "2 Main St", Zip: "12345"}, {}},
+		Tags:      []string{"ok", "n0t"},
 	}
 
-	errs = validate.Struct(test2)
+	errs := validate.Struct(u)
 	NotEqual(t, errs, nil)
 
-	ve := errs.(ValidationErrors)
-	Equal(t, len(ve), 2)
-	AssertError(t, errs, "Field3", "Field3", "Field3", "Field3", "required_with_all")
-	AssertError(t, errs, "Field5", "Field5", "Field5", "Field5", "required_with_all")
+	groups := errs.(ValidationErrors).GroupByParent()
+	Equal(t, len(groups), 4)
+
+	Equal(t, len(groups["User"]), 1)
+	Equal(t, groups["User"][0].Namespace(), "User.Name")
+
+	Equal(t, len(groups["User.Address"]), 1)
+	Equal(t, groups["User.Address"][0].Namespace(), "User.Address.Zip")
+
+	Equal(t, len(groups["User.Addresses[1]"]), 2)
+	Equal(t, groups["User.Addresses[1]"][0].Namespace(), "User.Addresses[1].Street")
+	Equal(t, groups["User.Addresses[1]"][1].Namespace(), "User.Addresses[1].Zip")
+
+	Equal(t, len(groups["User.Tags"]), 1)
+	Equal(t, groups["User.Tags"][0].Namespace(), "User.Tags[1]")
+
+	validate.SetNamespaceSeparator("/")
+	validate.SetNamespaceBrackets("(", ")")
+
+	errs = validate.Struct(u)
+	NotEqual(t, errs, nil)
+
+	groups = errs.(ValidationErrors).GroupByParent()
+	Equal(t, len(groups["User/Addresses(1)"]), 2)
+	Equal(t, len(groups["User/Tags"]), 1)
+
+	errs = validate.Var("", "required")
+	NotEqual(t, errs, nil)
+
+	groups = errs.(ValidationErrors).GroupByParent()
+	Equal(t, len(groups[""]), 1)
 }
 
-func TestRequiredWithout(t *testing.T) {
-	type Inner struct {
-		Field *string
+func TestValidationErrorsByTopField(t *testing.T) {
+	type Address struct {
+		Zip  string `validate:"required,numeric"`
+		City string `validate:"required"`
 	}
 
-	fieldVal := "test"
-	test := struct {
-		Inner  *Inner
-		Field1 string            `validate:"omitempty" json:"field_1"`
-		Field2 *string           `validate:"required_without=Field1" json:"field_2"`
-		Field3 map[string]string `validate:"required_without=Field2" json:"field_3"`
-		Field4 interface{}       `validate:"required_without=Field3" json:"field_4"`
-		Field5 string            `validate:"required_without=Field3" json:"field_5"`
-	}{
-		Inner:  &Inner{Field: &fieldVal},
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
+	type User struct {
+		Name      string    `validate:"required"`
+		Address   Address   `json:"address"`
+		Addresses []Address `validate:"dive"`
 	}
 
 	validate := New()
 
-	errs := validate.Struct(test)
-	Equal(t, errs, nil)
-
-	test2 := struct {
-		Inner  *Inner
-		Inner2 *Inner
-		Field1 string            `json:"field_1"`
-		Field2 *string           `validate:"required_without=Field1" json:"field_2"`
-		Field3 map[string]string `validate:"required_without=Field2" json:"field_3"`
-		Field4 interface{}       `validate:"required_without=Field3" json:"field_4"`
-		Field5 string            `validate:"required_without=Field3" json:"field_5"`
-		Field6 string            `validate:"required_without=Field1" json:"field_6"`
-		Field7 string            `validate:"required_without=Inner.Field" json:"field_7"`
-		Field8 string            `validate:"required_without=Inner.Field" json:"field_8"`
-	}{
-		Inner:  &Inner{},
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
+	u := User{
+		Address:   Address{Zip: "abc"},
+		Addresses: []Address{{Zip: "12345", City: "Springfield"}, {}},
 	}
 
-	errs = validate.Struct(&test2)
+	errs := validate.Struct(u)
 	NotEqual(t, errs, nil)
 
-	ve := errs.(ValidationErrors)
-	Equal(t, len(ve), 4)
-	AssertError(t, errs, "Field2", "Field2", "Field2", "Field2", "required_without")
-	AssertError(t, errs, "Field6", "Field6", "Field6", "Field6", "required_without")
-	AssertError(t, errs, "Field7", "Field7", "Field7", "Field7", "required_without")
-	AssertError(t, errs, "Field8", "Field8", "Field8", "Field8", "required_without")
+	fields := errs.(ValidationErrors).ByTopField()
+	Equal(t, len(fields), 3)
 
-	test3 := struct {
-		Field1 *string `validate:"required_without=Field2,omitempty,min=1" json:"field_1"`
-		Field2 *string `validate:"required_without=Field1,omitempty,min=1" json:"field_2"`
-	}{
-		Field1: &fieldVal,
-	}
+	Equal(t, len(fields["Name"]), 1)
+	Equal(t, fields["Name"][0], "Key: 'User.Name' Error:Field validation for 'Name' failed on the 'required' tag")
 
-	errs = validate.Struct(&test3)
-	Equal(t, errs, nil)
-}
+	Equal(t, len(fields["Address"]), 2)
+	Equal(t, fields["Address"][0], "Key: 'User.Address.Zip' Error:Field validation for 'Zip' failed on the 'numeric' tag")
+	Equal(t, fields["Address"][1], "Key: 'User.Address.City' Error:Field validation for 'City' failed on the 'required' tag")
 
-func TestRequiredWithoutAll(t *testing.T) {
-	fieldVal := "test"
-	test := struct {
-		Field1 string            `validate:"omitempty" json:"field_1"`
-		Field2 *string           `validate:"required_without_all=Field1" json:"field_2"`
-		Field3 map[string]string `validate:"required_without_all=Field2" json:"field_3"`
-		Field4 interface{}       `validate:"required_without_all=Field3" json:"field_4"`
-		Field5 string            `validate:"required_without_all=Field3" json:"field_5"`
-	}{
-		Field1: "",
-		Field2: &fieldVal,
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
-	}
+	Equal(t, len(fields["Addresses"]), 2)
+	Equal(t, fields["Addresses"][0], "Key: 'User.Addresses[1].Zip' Error:Field validation for 'Zip' failed on the 'required' tag")
 
-	validate := New()
+	validate = New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+		if name == "" {
+			return fld.Name
+		}
+		return name
+	})
 
-	errs := validate.Struct(test)
-	Equal(t, errs, nil)
+	validate.SetNamespaceSeparator("/")
 
-	test2 := struct {
-		Field1 string            `validate:"omitempty" json:"field_1"`
-		Field2 *string           `validate:"required_without_all=Field1" json:"field_2"`
-		Field3 map[string]string `validate:"required_without_all=Field2" json:"field_3"`
-		Field4 interface{}       `validate:"required_without_all=Field3" json:"field_4"`
-		Field5 string            `validate:"required_without_all=Field3" json:"field_5"`
-		Field6 string            `validate:"required_without_all=Field1 Field3" json:"field_6"`
-	}{
-		Field3: map[string]string{"key": "val"},
-		Field4: "test",
-		Field5: "test",
-	}
+	errs = validate.Struct(u)
+	NotEqual(t, errs, nil)
 
-	errs = validate.Struct(test2)
+	fields = errs.(ValidationErrors).ByTopField()
+	Equal(t, len(fields["address"]), 2)
+	Equal(t, len(fields["Address"]), 0)
+
+	errs = validate.Var("", "required")
 	NotEqual(t, errs, nil)
 
-	ve := errs.(ValidationErrors)
-	Equal(t, len(ve), 1)
-	AssertError(t, errs, "Field2", "Field2", "Field2", "Field2", "required_without_all")
+	fields = errs.(ValidationErrors).ByTopField()
+	Equal(t, len(fields[""]), 1)
 }
 
-func TestLookup(t *testing.T) {
-	type Lookup struct {
-		FieldA *string `json:"fieldA,omitempty" validate:"required_without=FieldB"`
-		FieldB *string `json:"fieldB,omitempty" validate:"required_without=FieldA"`
+func TestValidationErrorsTree(t *testing.T) {
+	type Address struct {
+		Street string `validate:"required"`
+		City   string `validate:"required"`
 	}
 
-	fieldAValue := "1232"
-	lookup := Lookup{
-		FieldA: &fieldAValue,
-		FieldB: nil,
+	type User struct {
+		Email     string            `validate:"required,email"`
+		Addresses []Address         `validate:"dive"`
+		Tags      map[string]string `validate:"dive,required"`
+		Primary   Address           `validate:"required"`
+		Scores    [][]int           `validate:"dive,dive,gt=0"`
 	}
-	Equal(t, New().Struct(lookup), nil)
-}
 
-func TestAbilityToValidateNils(t *testing.T) {
-	type TestStruct struct {
-		Test *string `validate:"nil"`
+	type Test struct {
+		Name  string `validate:"required"`
+		Users []User `validate:"dive"`
 	}
 
-	ts := TestStruct{}
-	val := New()
-	fn := func(fl FieldLevel) bool {
-		return fl.Field().Kind() == reflect.Ptr && fl.Field().IsNil()
+	validate := New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		if sl.Current().Interface().(User).Email == "" {
+			sl.ReportError(sl.Current().Interface(), "Primary", "Primary", "primary", "")
+		}
+	}, User{})
+
+	test := Test{
+		Users: []User{
+			{Email: "joey@example.com", Primary: Address{Street: "a", City: "b"}},
+			{
+				Email:     "bad",
+				Addresses: []Address{{Street: "a", City: "b"}, {}},
+				Tags:      map[string]string{"k": ""},
+				Primary:   Address{Street: "a", City: "b"},
+				Scores:    [][]int{{1}, {1, 0}},
+			},
+			{Primary: Address{Street: "a"}},
+		},
 	}
 
-	err := val.RegisterValidation("nil", fn, true)
-	Equal(t, err, nil)
+	errs := validate.Struct(test)
+	NotEqual(t, errs, nil)
 
-	errs := val.Struct(ts)
-	Equal(t, errs, nil)
+	tree := errs.(ValidationErrors).Tree()
 
-	str := "string"
-	ts.Test = &str
+	Equal(t, tree["Name"].(FieldError).Tag(), "required")
 
-	errs = val.Struct(ts)
+	users := tree["Users"].([]interface{})
+	Equal(t, len(users), 3)
+	Equal(t, users[0], nil)
+
+	user := users[1].(map[string]interface{})
+	Equal(t, user["Email"].(FieldError).Tag(), "email")
+
+	addresses := user["Addresses"].([]interface{})
+	Equal(t, len(addresses), 2)
+	Equal(t, addresses[0], nil)
+	Equal(t, addresses[1].(map[string]interface{})["Street"].(FieldError).Tag(), "required")
+	Equal(t, addresses[1].(map[string]interface{})["City"].(FieldError).Tag(), "required")
+
+	Equal(t, user["Tags"].(map[string]interface{})["k"].(FieldError).Tag(), "required")
+
+	scores := user["Scores"].([]interface{})
+	Equal(t, len(scores), 2)
+	Equal(t, scores[0], nil)
+	Equal(t, scores[1].([]interface{})[1].(FieldError).Tag(), "gt")
+
+	// conflicting leaf and branch, the fields own error is stored under ""
+	user = users[2].(map[string]interface{})
+	Equal(t, user["Email"].(FieldError).Tag(), "required")
+	primary := user["Primary"].(map[string]interface{})
+	Equal(t, primary["City"].(FieldError).Tag(), "required")
+	Equal(t, primary[""].(FieldError).Tag(), "primary")
+
+	// single variable validation
+	errs = validate.Var("", "required")
+	NotEqual(t, errs, nil)
+	tree = errs.(ValidationErrors).Tree()
+	Equal(t, tree[""].(FieldError).Tag(), "required")
+
+	// anonymous top level struct and custom namespace syntax
+	validate.SetNamespaceSeparator("/")
+	validate.SetNamespaceBrackets("<", ">")
+
+	errs = validate.Struct(struct {
+		Items []Address `validate:"dive"`
+	}{Items: []Address{{Street: "a"}}})
 	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Items<0>/City", "Items<0>/City", "City", "City", "required")
+
+	tree = errs.(ValidationErrors).Tree()
+	Equal(t, tree["Items"].([]interface{})[0].(map[string]interface{})["City"].(FieldError).Tag(), "required")
 }
 
-func TestRequiredWithoutPointers(t *testing.T) {
-	type Lookup struct {
-		FieldA *bool `json:"fieldA,omitempty" validate:"required_without=FieldB"`
-		FieldB *bool `json:"fieldB,omitempty" validate:"required_without=FieldA"`
+func TestLenFieldValidation(t *testing.T) {
+	type Test struct {
+		Names   []string
+		Ages    []int          `validate:"lenfield=Names"`
+		Index   map[string]int `validate:"lenfield=Names"`
+		Code    string         `validate:"lenfield=Names"`
+		Fewer   []int          `validate:"ltlenfield=Names"`
+		AtMost  [2]int         `validate:"ltelenfield=Names"`
+		More    string         `validate:"gtlenfield=Names"`
+		AtLeast []string       `validate:"gtelenfield=Names"`
 	}
 
-	b := true
-	lookup := Lookup{
-		FieldA: &b,
-		FieldB: nil,
+	validate := New()
+
+	test := Test{
+		Names:   []string{"a", "b"},
+		Ages:    []int{1, 2},
+		Index:   map[string]int{"a": 1, "b": 2},
+		Code:    "äö",
+		Fewer:   []int{1},
+		More:    "abc",
+		AtLeast: []string{"a", "b"},
 	}
 
-	val := New()
-	errs := val.Struct(lookup)
+	errs := validate.Struct(test)
 	Equal(t, errs, nil)
 
-	b = false
-	lookup = Lookup{
-		FieldA: &b,
-		FieldB: nil,
-	}
-	errs = val.Struct(lookup)
-	Equal(t, errs, nil)
-}
+	test.Names = []string{"a", "b", "c"}
 
-func TestRequiredWithoutAllPointers(t *testing.T) {
-	type Lookup struct {
-		FieldA *bool `json:"fieldA,omitempty" validate:"required_without_all=FieldB"`
-		FieldB *bool `json:"fieldB,omitempty" validate:"required_without_all=FieldA"`
-	}
+	errs = validate.Struct(test)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 5)
+	AssertError(t, errs, "Test.Ages", "Test.Ages", "Ages", "Ages", "lenfield")
+	AssertError(t, errs, "Test.Index", "Test.Index", "Index", "Index", "lenfield")
+	AssertError(t, errs, "Test.Code", "Test.Code", "Code", "Code", "lenfield")
+	AssertError(t, errs, "Test.More", "Test.More", "More", "More", "gtlenfield")
+	AssertError(t, errs, "Test.AtLeast", "Test.AtLeast", "AtLeast", "AtLeast", "gtelenfield")
 
-	b := true
-	lookup := Lookup{
-		FieldA: &b,
-		FieldB: nil,
-	}
+	test.Names = []string{"a"}
 
-	val := New()
-	errs := val.Struct(lookup)
-	Equal(t, errs, nil)
+	errs = validate.Struct(test)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Fewer", "Test.Fewer", "Fewer", "Fewer", "ltlenfield")
+	AssertError(t, errs, "Test.AtMost", "Test.AtMost", "AtMost", "AtMost", "ltelenfield")
 
-	b = false
-	lookup = Lookup{
-		FieldA: &b,
-		FieldB: nil,
+	type Missing struct {
+		Count int
+		Ages  []int `validate:"lenfield=Count"`
+		Other []int `validate:"lenfield=Nope"`
 	}
-	errs = val.Struct(lookup)
-	Equal(t, errs, nil)
-}
 
-func TestGetTag(t *testing.T) {
-	var tag string
+	errs = validate.Struct(Missing{})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
 
-	type Test struct {
-		String string `validate:"mytag"`
+	type Bad struct {
+		Names []string
+		Count int `validate:"lenfield=Names"`
 	}
 
-	val := New()
-	_ = val.RegisterValidation("mytag", func(fl FieldLevel) bool {
-		tag = fl.GetTag()
-		return true
-	})
-
-	var test Test
-	errs := val.Struct(test)
-	Equal(t, errs, nil)
-	Equal(t, tag, "mytag")
+	PanicMatches(t, func() { _ = validate.Struct(Bad{}) }, "Bad field type int")
 }
 
 func TestJSONValidation(t *testing.T) {
@@ -10770,6 +16436,58 @@ func TestJSONValidation(t *testing.T) {
 	PanicMatches(t, func() {
 		_ = validate.Var(2, "json")
 	}, "Bad field type int")
+
+	PanicMatches(t, func() {
+		_ = validate.Var([]int{1}, "json")
+	}, "Bad field type []int")
+}
+
+func TestJSONRawMessageValidation(t *testing.T) {
+	type Event struct {
+		Payload  json.RawMessage  `validate:"required,json,min=2,max=16"`
+		Metadata *json.RawMessage `validate:"omitempty,json"`
+		Raw      []byte           `validate:"omitempty,json"`
+	}
+
+	validate := New()
+
+	errs := validate.Struct(Event{Payload: json.RawMessage(`{"id":1}`)})
+	Equal(t, errs, nil)
+
+	meta := json.RawMessage(`["a","b"]`)
+	errs = validate.Struct(Event{Payload: json.RawMessage(`[]`), Metadata: &meta, Raw: []byte(`null`)})
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Event{Payload: json.RawMessage(`{"id":`)})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Event.Payload", "Event.Payload", "Payload", "Payload", "json")
+
+	// length is that of the raw bytes
+	errs = validate.Struct(Event{Payload: json.RawMessage(`1`)})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Event.Payload", "Event.Payload", "Payload", "Payload", "min")
+
+	errs = validate.Struct(Event{Payload: json.RawMessage(`{"id":1234567890}`)})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Event.Payload", "Event.Payload", "Payload", "Payload", "max")
+
+	errs = validate.Struct(Event{})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Event.Payload", "Event.Payload", "Payload", "Payload", "required")
+
+	meta = json.RawMessage(`{`)
+	errs = validate.Struct(Event{Payload: json.RawMessage(`{}`), Metadata: &meta, Raw: []byte(`nul`)})
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Event.Metadata", "Event.Metadata", "Metadata", "Metadata", "json")
+	AssertError(t, errs, "Event.Raw", "Event.Raw", "Raw", "Raw", "json")
+	// validated by the json tag as bytes, the value reported being the json.RawMessage
+	Equal(t, getError(errs, "Event.Metadata", "Event.Metadata").Value(), json.RawMessage(`{`))
+	Equal(t, getError(errs, "Event.Raw", "Event.Raw").Value(), []byte(`nul`))
+
+	errs = validate.Var(json.RawMessage(`{"ok":true}`), "json,len=11")
+	Equal(t, errs, nil)
 }
 
 func Test_hostnameport_validator(t *testing.T) {
@@ -11011,8 +16729,13 @@ func TestTimeZoneValidation(t *testing.T) {
 		{"America/New_York", `timezone`, true},
 		{"UTC", `timezone`, true},
 		{"", `timezone`, false},
-		{"Local", `timezone`, false},
+		{"Local", `timezone`, true},
+		{"local", `timezone`, false},
 		{"Unknown", `timezone`, false},
+		{"Europe/Berlin", `timezone`, true},
+		{"Europe/Berlin", `timezone`, true},
+		{"Europe/Nowhere", `timezone`, false},
+		{"Europe/Nowhere", `timezone`, false},
 	}
 
 	validate := New()
@@ -11037,6 +16760,22 @@ func TestTimeZoneValidation(t *testing.T) {
 		}
 	}
 
+	timeZoneCacheRWLock.RLock()
+	_, cached := timeZoneCache["Europe/Berlin"]
+	_, invalidCached := timeZoneCache["Europe/Nowhere"]
+	timeZoneCacheRWLock.RUnlock()
+	Equal(t, cached, true)
+	Equal(t, invalidCached, false)
+
+	// "Local", resolving to the system's time zone, is accepted as "UTC" is
+	type Config struct {
+		Zone string `validate:"timezone"`
+	}
+
+	Equal(t, validate.Struct(Config{Zone: "Local"}), nil)
+	Equal(t, validate.Struct(Config{Zone: "UTC"}), nil)
+	NotEqual(t, validate.Struct(Config{}), nil)
+
 	PanicMatches(t, func() {
 		_ = validate.Var(2, "timezone")
 	}, "Bad field type int")